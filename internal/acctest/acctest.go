@@ -61,7 +61,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
@@ -161,7 +160,7 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, _, err := protoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
@@ -194,7 +193,7 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
 	for _, name := range providerNames {
-		providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+		providerServerFactory, p, err := protoV5ProviderServerFactory(ctx)
 
 		if err != nil {
 			t.Fatal(err)
@@ -216,7 +215,7 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 	factories := make(map[string]func() (tfprotov5.ProviderServer, error), len(providerNames))
 
 	for _, name := range providerNames {
-		providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+		providerServerFactory, p, err := protoV5ProviderServerFactory(ctx)
 
 		if err != nil {
 			t.Fatal(err)
@@ -317,13 +316,22 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 func PreCheck(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	if emulatorEnabled() {
+		emulatorPreCheck(t)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		// An AWS emulator doesn't require credentials.
+		if emulatorEnabled() {
+			Provider.ConfigureContextFunc = emulatorProviderConfigureContextFunc(Provider, Provider.ConfigureContextFunc)
+		} else {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
-		if os.Getenv(envvar.AccessKeyId) != "" {
-			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			if os.Getenv(envvar.AccessKeyId) != "" {
+				envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
+			}
 		}
 
 		// Setting the AWS_DEFAULT_REGION environment variable here allows all tests to omit
@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// This file contains helper functions for running acceptance tests against
+// a local AWS emulator, such as LocalStack or moto in server mode.
+//
+// When the TF_ACC_EMULATOR_ENDPOINT environment variable is set, every
+// service endpoint in the provider's `endpoints` block is set to the emulator's
+// URL and dummy credentials are used. Emulators only support a subset of AWS
+// services and operations, so an allowlist file named by the
+// TF_ACC_EMULATOR_ALLOWLIST environment variable can be used to skip tests
+// that are not supported by the emulator.
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Credentials used with an emulator when none are configured.
+	emulatorAccessKey = "test"
+	emulatorSecretKey = "test"
+)
+
+// emulatorEnabled indicates whether acceptance tests are run against a local AWS emulator.
+func emulatorEnabled() bool {
+	return os.Getenv(envvar.AccEmulatorEndpoint) != ""
+}
+
+// protoV5ProviderServerFactory returns a provider server factory and the primary (SDKv2) provider,
+// whose configuration uses the local AWS emulator if enabled.
+func protoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
+	providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if emulatorEnabled() {
+		primary.ConfigureContextFunc = emulatorProviderConfigureContextFunc(primary, primary.ConfigureContextFunc)
+	}
+
+	return providerServerFactory, primary, nil
+}
+
+// emulatorProviderConfigureContextFunc returns a provider configuration function
+// that points all service endpoints at the local AWS emulator before configuring the provider.
+func emulatorProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if err := emulatorConfigure(provider, d); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring AWS emulator: %s", err)
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// emulatorConfigure sets the provider configuration used with the local AWS emulator.
+func emulatorConfigure(provider *schema.Provider, d *schema.ResourceData) error {
+	endpoint := os.Getenv(envvar.AccEmulatorEndpoint)
+
+	v, ok := provider.Schema["endpoints"].Elem.(*schema.Resource)
+	if !ok {
+		return fmt.Errorf("unexpected endpoints schema")
+	}
+
+	endpoints := make(map[string]any)
+	for _, service := range names.ProviderPackages() {
+		if _, ok := v.Schema[service]; ok {
+			endpoints[service] = endpoint
+		}
+	}
+
+	if err := d.Set("endpoints", []any{endpoints}); err != nil {
+		return err
+	}
+
+	if d.Get("access_key").(string) == "" && d.Get("profile").(string) == "" {
+		if err := d.Set("access_key", emulatorAccessKey); err != nil {
+			return err
+		}
+		if err := d.Set("secret_key", emulatorSecretKey); err != nil {
+			return err
+		}
+	}
+
+	// Emulators serve all buckets from the same host.
+	if err := d.Set("s3_use_path_style", true); err != nil {
+		return err
+	}
+
+	return d.Set("skip_metadata_api_check", "true")
+}
+
+// emulatorAllowlist is the format of the emulator allowlist file.
+// Each service block allows a service's acceptance tests to run against the emulator.
+// Tests for services without a block are skipped.
+type emulatorAllowlist struct {
+	Services []emulatorAllowlistService `hcl:"service,block"`
+}
+
+type emulatorAllowlistService struct {
+	Service string `hcl:",label"`
+	// Regular expression matching the names of tests to run. Defaults to all tests.
+	IncludePattern string `hcl:"include_pattern,optional"`
+	// Regular expression matching the names of tests that the emulator does not support.
+	ExcludePattern string `hcl:"exclude_pattern,optional"`
+	// Reason tests are excluded, included in skip messages.
+	Reason string `hcl:"reason,optional"`
+}
+
+// emulatorServiceRules are the compiled rules for a service in the emulator allowlist.
+type emulatorServiceRules struct {
+	include *regexp.Regexp
+	exclude *regexp.Regexp
+	reason  string
+}
+
+// readEmulatorAllowlist reads the emulator allowlist file, if any.
+// A nil map is returned if no allowlist file is configured.
+var readEmulatorAllowlist = sync.OnceValues(func() (map[string]emulatorServiceRules, error) {
+	filename := os.Getenv(envvar.AccEmulatorAllowlist)
+	if filename == "" {
+		return nil, nil
+	}
+
+	return parseEmulatorAllowlist(filename)
+})
+
+func parseEmulatorAllowlist(filename string) (map[string]emulatorServiceRules, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	var allowlist emulatorAllowlist
+	if err := hclsimple.Decode(filename, b, nil, &allowlist); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", filename, err)
+	}
+
+	rules := make(map[string]emulatorServiceRules, len(allowlist.Services))
+	for _, v := range allowlist.Services {
+		if _, ok := rules[v.Service]; ok {
+			return nil, fmt.Errorf("%s: duplicate service %q", filename, v.Service)
+		}
+
+		var r emulatorServiceRules
+		if v.IncludePattern != "" {
+			if r.include, err = regexp.Compile(v.IncludePattern); err != nil {
+				return nil, fmt.Errorf("%s: service %q: include_pattern: %w", filename, v.Service, err)
+			}
+		}
+		if v.ExcludePattern != "" {
+			if r.exclude, err = regexp.Compile(v.ExcludePattern); err != nil {
+				return nil, fmt.Errorf("%s: service %q: exclude_pattern: %w", filename, v.Service, err)
+			}
+		}
+		r.reason = v.Reason
+
+		rules[v.Service] = r
+	}
+
+	return rules, nil
+}
+
+// emulatorSkipReason returns why the specified service's test is not run against the emulator,
+// or an empty string if it is supported.
+func emulatorSkipReason(rules map[string]emulatorServiceRules, service, testName string) string {
+	if rules == nil {
+		return ""
+	}
+
+	r, ok := rules[service]
+	if !ok {
+		return fmt.Sprintf("service %q is not in the AWS emulator allowlist", service)
+	}
+
+	// Subtests are matched by their top-level test name.
+	testName, _, _ = strings.Cut(testName, "/")
+
+	if r.include != nil && !r.include.MatchString(testName) {
+		return fmt.Sprintf("test is not included in the AWS emulator allowlist for service %q", service)
+	}
+
+	if r.exclude != nil && r.exclude.MatchString(testName) {
+		if r.reason != "" {
+			return fmt.Sprintf("test is not supported by the AWS emulator: %s", r.reason)
+		}
+		return "test is not supported by the AWS emulator"
+	}
+
+	return ""
+}
+
+// emulatorPreCheck skips the test if it is not supported by the emulator.
+func emulatorPreCheck(t *testing.T) {
+	t.Helper()
+
+	rules, err := readEmulatorAllowlist()
+	if err != nil {
+		t.Fatalf("reading AWS emulator allowlist: %s", err)
+	}
+
+	if rules == nil {
+		return
+	}
+
+	service := callerServicePackage()
+	if service == "" {
+		t.Skip("unable to determine the service package of test for the AWS emulator allowlist")
+	}
+
+	if reason := emulatorSkipReason(rules, service, t.Name()); reason != "" {
+		t.Skip(reason)
+	}
+}
+
+// callerServicePackage returns the name of the service package from which the test is being run.
+func callerServicePackage() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		if service := servicePackageFromFile(frame.File); service != "" {
+			return service
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// servicePackageFromFile returns the name of the service package containing the specified source file.
+func servicePackageFromFile(filename string) string {
+	const dir = "/internal/service/"
+
+	_, after, ok := strings.Cut(filename, dir)
+	if !ok {
+		return ""
+	}
+
+	service, _, ok := strings.Cut(after, "/")
+	if !ok {
+		return ""
+	}
+
+	return service
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEmulatorSkipReason(t *testing.T) {
+	t.Parallel()
+
+	filename := filepath.Join(t.TempDir(), "allowlist.hcl")
+	if err := os.WriteFile(filename, []byte(`
+service "sqs" {}
+
+service "s3" {
+  exclude_pattern = "TestAccS3Bucket_(Replication|ObjectLock)"
+  reason          = "replication and object lock are not emulated"
+}
+
+service "logs" {
+  include_pattern = "TestAccLogsLogGroup_"
+}
+`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := acctest.ParseEmulatorAllowlist(filename)
+	if err != nil {
+		t.Fatalf("ParseEmulatorAllowlist: %s", err)
+	}
+
+	testCases := []struct {
+		service  string
+		testName string
+		wantSkip bool
+	}{
+		{service: "sqs", testName: "TestAccSQSQueue_basic"},
+		{service: "s3", testName: "TestAccS3Bucket_basic"},
+		{service: "s3", testName: "TestAccS3Bucket_Replication_basic", wantSkip: true},
+		{service: "s3", testName: "TestAccS3Bucket_ObjectLock/enabled", wantSkip: true},
+		{service: "logs", testName: "TestAccLogsLogGroup_basic"},
+		{service: "logs", testName: "TestAccLogsDestination_basic", wantSkip: true},
+		{service: "ec2", testName: "TestAccVPC_basic", wantSkip: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			reason := acctest.EmulatorSkipReason(rules, testCase.service, testCase.testName)
+			if got, want := reason != "", testCase.wantSkip; got != want {
+				t.Errorf("EmulatorSkipReason() = %q, want skip %t", reason, want)
+			}
+		})
+	}
+
+	if reason := acctest.EmulatorSkipReason(nil, "ec2", "TestAccVPC_basic"); reason != "" {
+		t.Errorf("EmulatorSkipReason() with no allowlist = %q, want no skip", reason)
+	}
+}
+
+func TestParseEmulatorAllowlistInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, content := range map[string]string{
+		"duplicate service": "service \"s3\" {}\nservice \"s3\" {}\n",
+		"invalid pattern":   "service \"s3\" {\n  exclude_pattern = \"(\"\n}\n",
+		"unknown attribute": "service \"s3\" {\n  tests = []\n}\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "allowlist.hcl")
+			if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := acctest.ParseEmulatorAllowlist(filename); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestServicePackageFromFile(t *testing.T) {
+	t.Parallel()
+
+	for filename, want := range map[string]string{
+		"/src/terraform-provider-aws/internal/service/s3/bucket_test.go": "s3",
+		"/src/terraform-provider-aws/internal/acctest/vcr.go":            "",
+		"/usr/local/go/src/testing/testing.go":                           "",
+	} {
+		if got := acctest.ServicePackageFromFile(filename); got != want {
+			t.Errorf("ServicePackageFromFile(%q) = %q, want %q", filename, got, want)
+		}
+	}
+}
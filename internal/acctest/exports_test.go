@@ -5,5 +5,8 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder       = closeVCRRecorder
+	EmulatorSkipReason     = emulatorSkipReason
+	ParseEmulatorAllowlist = parseEmulatorAllowlist
+	ServicePackageFromFile = servicePackageFromFile
 )
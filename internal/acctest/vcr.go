@@ -26,28 +26,24 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
@@ -170,9 +166,13 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
 		// Create a VCR recorder around a default HTTP client.
+		// Interactions are redacted before being saved so that the provider sees unredacted responses while recording.
+		// Requests are matched to stored interactions according to their Smithy protocol, ignoring volatile fields.
+		redactor := vcr.NewRedactor(vcr.DefaultRedactionRules()...)
+		matcher := vcr.NewMatcher(redactor, vcr.DefaultVolatileFields())
 		r, err := recorder.New(cassetteName,
-			recorder.WithHook(vcrSensitiveHeaderHook, recorder.AfterCaptureHook),
-			recorder.WithMatcher(vcrMatcherFunc(ctx)),
+			recorder.WithHook(redactor.Hook, recorder.BeforeSaveHook),
+			recorder.WithMatcher(matcher.Match),
 			recorder.WithMode(vcrMode),
 			recorder.WithRealTransport(httpClient.Transport),
 			recorder.WithSkipRequestLatency(true),
@@ -185,7 +185,10 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient.Transport = &vcrTransport{
+			Recorder: r,
+			matcher:  matcher,
+		}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -214,72 +217,24 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
-// vcrSensitiveHeaderHook is an after capture hook to remove sensitive HTTP headers.
-func vcrSensitiveHeaderHook(i *cassette.Interaction) error {
-	delete(i.Request.Headers, "Authorization")
-	delete(i.Request.Headers, "X-Amz-Security-Token")
-	return nil
+// vcrTransport wraps a VCR recorder, describing why a request did not match any recorded interaction.
+type vcrTransport struct {
+	*recorder.Recorder
+	matcher *vcr.Matcher
 }
 
-// vcrMatcherFunc defines how VCR will match requests to stored interactions.
-func vcrMatcherFunc(ctx context.Context) recorder.MatcherFunc {
-	return func(r *http.Request, i cassette.Request) bool {
-		if r.Method != i.Method {
-			return false
-		}
-
-		if r.URL.String() != i.URL {
-			return false
-		}
-
-		if r.Body == nil {
-			return true
-		}
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	defer t.matcher.Done(r)
 
-		var b bytes.Buffer
-		if _, err := b.ReadFrom(r.Body); err != nil {
-			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-				"error": err,
-			})
-			return false
-		}
+	resp, err := t.Recorder.RoundTrip(r)
 
-		r.Body = io.NopCloser(&b)
-		body := b.String()
-		// If body matches identically, we are done.
-		if body == i.Body {
-			return true
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		if mismatch := t.matcher.Mismatch(r); mismatch != "" {
+			return nil, fmt.Errorf("%w: %s", err, mismatch)
 		}
-
-		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-		switch contentType := r.Header.Get("Content-Type"); contentType {
-		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-			// JSON might be the same, but reordered. Try parsing and comparing.
-			return tfjson.EqualStrings(body, i.Body)
-
-		case "application/xml":
-			// XML might be the same, but reordered. Try parsing and comparing.
-			var requestXML, cassetteXML any
-
-			if err := xml.Unmarshal([]byte(body), &requestXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			if err := xml.Unmarshal([]byte(i.Body), &cassetteXML); err != nil {
-				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			return reflect.DeepEqual(requestXML, cassetteXML)
-		}
-
-		return false
 	}
+
+	return resp, err
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing
@@ -350,7 +305,7 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
+			if v, ok := meta.HTTPClient(ctx).Transport.(*vcrTransport); ok {
 				t.Log("stopping VCR recorder")
 				if err := v.Stop(); err != nil {
 					t.Error(err)
@@ -386,10 +341,18 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
+// ParallelTest wraps resource.ParallelTest, initializing VCR or an AWS emulator if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if emulatorEnabled() {
+		if vcr.IsEnabled() {
+			t.Fatal("go-vcr is not supported with an AWS emulator")
+		}
+
+		emulatorPreCheck(t)
+	}
+
 	if vcr.IsEnabled() {
 		if c.ProtoV5ProviderFactories != nil {
 			c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
@@ -402,10 +365,18 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled
+// Test wraps resource.Test, initializing VCR or an AWS emulator if enabled
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if emulatorEnabled() {
+		if vcr.IsEnabled() {
+			t.Fatal("go-vcr is not supported with an AWS emulator")
+		}
+
+		emulatorPreCheck(t)
+	}
+
 	if vcr.IsEnabled() {
 		if c.ProtoV5ProviderFactories != nil {
 			c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
@@ -11,6 +11,7 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -23,8 +24,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -39,7 +42,9 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	policyLintConfig          *policylint.Config
+	randomnessSource          rand.Source         // For VCR deterministic randomness.
+	rateLimiters              *ratelimit.Limiters // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -47,6 +52,7 @@ type AWSClient struct {
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
+	tagsReportingConfig       *tftags.ReportingConfig
 	terraformVersion          string // From provider configuration.
 }
 
@@ -78,11 +84,23 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default_tags configuration.
+// Within a resource's context, any rules limited to a subset of resources are resolved for that resource.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(inContext.TypeName(), inContext.ServicePackageName())
+	}
+
 	return c.defaultTagsConfig
 }
 
-func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
+// IgnoreTagsConfig returns the provider's ignore_tags configuration.
+// Within a resource's context, any rules limited to a subset of resources are resolved for that resource.
+func (c *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if inContext, ok := FromContext(ctx); ok {
+		return c.ignoreTagsConfig.ForResource(inContext.TypeName(), inContext.ServicePackageName())
+	}
+
 	return c.ignoreTagsConfig
 }
 
@@ -90,6 +108,14 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
+func (c *AWSClient) TagsReportingConfig(context.Context) *tftags.ReportingConfig {
+	return c.tagsReportingConfig
+}
+
+func (c *AWSClient) PolicyLintConfig(context.Context) *policylint.Config {
+	return c.policyLintConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -291,12 +317,7 @@ func (c *AWSClient) GlobalAcceleratorHostedZoneID(context.Context) string {
 
 // DNSSuffix returns the domain suffix for the configured AWS partition.
 func (c *AWSClient) DNSSuffix(context.Context) string {
-	dnsSuffix := c.partition.DNSSuffix()
-	if dnsSuffix == "" {
-		dnsSuffix = "amazonaws.com"
-	}
-
-	return dnsSuffix
+	return names.DNSSuffixForPartition(c.partition)
 }
 
 // ReverseDNSPrefix returns the reverse DNS prefix for the configured AWS partition.
@@ -359,6 +380,11 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if apiOption := c.rateLimiters.APIOption(servicePackageName); apiOption != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOption)
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -20,9 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -30,6 +33,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIOptions                     []func(*middleware.Stack) error
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -45,7 +49,9 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	PolicyLintConfig               *policylint.Config
 	Profile                        string
+	RateLimits                     []ratelimit.Config
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
@@ -60,6 +66,8 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
+	TagPolicyFile                  string
+	TagsReportingConfig            *tftags.ReportingConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -213,26 +221,55 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Fetch tag policy details when enforced
 	if c.TagPolicyConfig != nil {
-		tflog.Debug(ctx, "Retrieving tag policy details")
-		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
-		if err != nil {
-			diags = append(diags, errs.NewErrorDiagnostic(
-				"Retrieving Required Tags",
-				`Failed to retrieve required tags from the organizations tag policies. Ensure the calling principal `+
-					`has the "tag:ListRequiredTags" IAM permission and that tag policies are attached to the target account.`+
-					fmt.Sprintf("\n\nOriginal error: %s", err)))
-			return nil, diags
+		if c.TagPolicyFile != "" {
+			tflog.Debug(ctx, "Reading tag policy file", map[string]any{
+				"tag_policy_file": c.TagPolicyFile,
+			})
+			policy, err := tagpolicy.ReadPolicyFile(ctx, c.TagPolicyFile)
+			if err != nil {
+				diags = append(diags, errs.NewErrorDiagnostic(
+					"Reading Tag Policy File",
+					fmt.Sprintf("Failed to read the organizations tag policy file %q.\n\nOriginal error: %s", c.TagPolicyFile, err)))
+				return nil, diags
+			}
+			c.TagPolicyConfig.RequiredTags = policy.RequiredTags
+			c.TagPolicyConfig.EnforcedTagRules = policy.EnforcedTagRules
+		} else {
+			tflog.Debug(ctx, "Retrieving tag policy details")
+			reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
+			if err != nil {
+				diags = append(diags, errs.NewErrorDiagnostic(
+					"Retrieving Required Tags",
+					`Failed to retrieve required tags from the organizations tag policies. Ensure the calling principal `+
+						`has the "tag:ListRequiredTags" IAM permission and that tag policies are attached to the target account.`+
+						fmt.Sprintf("\n\nOriginal error: %s", err)))
+				return nil, diags
+			}
+			c.TagPolicyConfig.RequiredTags = reqTags
+
+			// Allowed tag values are only enforced when the effective tag policy can be read.
+			policy, err := tagpolicy.GetEffectivePolicy(ctx, cfg)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to retrieve effective tag policy, tag values will not be validated", map[string]any{
+					"error": err.Error(),
+				})
+			} else {
+				c.TagPolicyConfig.EnforcedTagRules = policy.EnforcedTagRules
+			}
 		}
-		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.policyLintConfig = c.PolicyLintConfig
+	client.rateLimiters = ratelimit.New(c.RateLimits)
 	client.tagPolicyConfig = c.TagPolicyConfig
+	client.tagsReportingConfig = c.TagsReportingConfig
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
+	cfg.APIOptions = append(cfg.APIOptions, c.APIOptions...)
 	client.awsConfig = &cfg
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
@@ -186,6 +186,36 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Levels returns the nodes of the dependency graph grouped into levels.
+// Every node's dependencies are in earlier levels, so the nodes in a level can be processed concurrently
+// once all previous levels have been processed. Within a level nodes are in overall order.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Levels() ([][]string, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make([][]string, 0)
+	levelOf := make(map[string]int, len(order))
+
+	for _, node := range order {
+		level := 0
+		for _, dependency := range g.outgoingEdges[node] {
+			// Dependencies precede the node in the overall order.
+			level = max(level, levelOf[dependency]+1)
+		}
+
+		levelOf[node] = level
+		if level == len(levels) {
+			levels = append(levels, make([]string, 0))
+		}
+		levels[level] = append(levels[level], node)
+	}
+
+	return levels, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
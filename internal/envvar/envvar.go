@@ -78,6 +78,13 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For running acceptance tests against a local AWS emulator, such as LocalStack, the emulator's URL
+	// All service endpoints are set to this URL
+	AccEmulatorEndpoint = "TF_ACC_EMULATOR_ENDPOINT"
+
+	// For running acceptance tests against a local AWS emulator, the path of a file listing the tests the emulator supports
+	AccEmulatorAllowlist = "TF_ACC_EMULATOR_ALLOWLIST"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -96,6 +103,29 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for filtering the resources deleted by resource sweepers
+const (
+	// Comma-separated list of name prefixes, one of which a resource's name must have to be swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Comma-separated list of KEY=VALUE (or KEY, for any value) tags a resource must have to be swept
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Minimum age, as a Go duration (e.g. 6h), of a resource to be swept
+	SweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
+
+// Custom environment variables used for reporting the progress of long-running waits
+const (
+	// Elapsed time, as a Go duration (e.g. 30m), after which a wait for a resource to reach a state is reported as a warning.
+	// Set to 0 to disable warnings.
+	WaitWarningThreshold = "TF_AWS_WAIT_WARNING_THRESHOLD"
+
+	// Path of the file in which the durations of completed waits are recorded to estimate the time remaining for later waits.
+	// If not set, wait durations are only recorded in memory.
+	WaitHistoryFile = "TF_AWS_WAIT_HISTORY_FILE"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"unique"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -36,6 +38,7 @@ type listResourceWithSDKv2Resource[T listresource.InterceptorParamsSDK] struct {
 	identitySchema *schema.ResourceIdentity
 	regionSpec     unique.Handle[inttypes.ServicePackageResourceRegion]
 	interceptors   []listresource.ListResultInterceptor[T]
+	readOnInclude  bool
 }
 
 func (l *listResourceWithSDKv2Resource[T]) AppendResultInterceptor(interceptor listresource.ListResultInterceptor[T]) {
@@ -123,6 +126,13 @@ func (l *listResourceWithSDKv2Resource[T]) SetResourceSchema(resource *schema.Re
 	l.resourceSchema = resource
 }
 
+// SetReadOnInclude indicates that the list resource sets resource state from the listing API's output
+// rather than calling the resource's Read, and that the resource's Read should be called when the
+// full resource state is requested.
+func (l *listResourceWithSDKv2Resource[T]) SetReadOnInclude() {
+	l.readOnInclude = true
+}
+
 func (l *listResourceWithSDKv2Resource[T]) ResourceData() *schema.ResourceData {
 	return l.resourceSchema.Data(&terraform.InstanceState{})
 }
@@ -178,6 +188,15 @@ func getAttributeOk(d resourceData, name string) (string, bool) {
 // TODO modify to accept func() as parameter
 // will allow to use before interceptors as well
 func (l *listResourceWithSDKv2Resource[T]) SetResult(ctx context.Context, awsClient *conns.AWSClient, includeResource bool, result *list.ListResult, rd *schema.ResourceData) {
+	if includeResource && l.readOnInclude {
+		if query, ok := listresource.QueryFromContext(ctx); !ok || query.IncludeResource {
+			result.Diagnostics.Append(l.read(ctx, awsClient, rd)...)
+			if result.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	if err := l.runResultInterceptors(ctx, listresource.After, awsClient, rd); err.HasError() {
 		result.Diagnostics.Append(err...)
 		return
@@ -244,3 +263,29 @@ func (l *listResourceWithSDKv2Resource[T]) SetResult(ctx context.Context, awsCli
 		}
 	}
 }
+
+// read hydrates the full resource state using the resource's own Read.
+func (l *listResourceWithSDKv2Resource[T]) read(ctx context.Context, awsClient *conns.AWSClient, rd *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	id := rd.Id()
+
+	switch {
+	case l.resourceSchema.ReadWithoutTimeout != nil:
+		diags.Append(fwdiag.FromSDKDiagnostics(l.resourceSchema.ReadWithoutTimeout(ctx, rd, awsClient))...)
+	case l.resourceSchema.ReadContext != nil:
+		diags.Append(fwdiag.FromSDKDiagnostics(l.resourceSchema.ReadContext(ctx, rd, awsClient))...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if rd.Id() == "" {
+		diags.AddError(
+			"Error Listing Remote Resources",
+			fmt.Sprintf("Resource %q was not found while reading its full state.", id),
+		)
+	}
+
+	return diags
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 var (
@@ -106,7 +107,7 @@ func ARNValue(value string) ARN {
 	// swallow any ARN parsing errors here and just pass along the
 	// zero value arn.ARN. Invalid values will be handled downstream
 	// by the ValidateAttribute method.
-	v, _ := arn.Parse(value)
+	v, _ := inttypes.ParseARN(value)
 
 	return ARN{
 		StringValue: basetypes.NewStringValue(value),
@@ -143,7 +144,7 @@ func (v ARN) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeR
 		return
 	}
 
-	if !arn.IsARN(v.ValueString()) {
+	if _, err := inttypes.ParseARN(v.ValueString()); err != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid ARN Value",
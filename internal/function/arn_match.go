@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = arnMatchFunction{}
+
+func NewARNMatchFunction() function.Function {
+	return &arnMatchFunction{}
+}
+
+type arnMatchFunction struct{}
+
+func (f arnMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_match"
+}
+
+func (f arnMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_match Function",
+		MarkdownDescription: "Checks whether an ARN matches a pattern. Each colon-separated section of the pattern may use " +
+			"IAM-style wildcards, where * matches any sequence of characters and ? matches any single character",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, e.g. arn:*:iam::*:role/*",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := inttypes.ARNMatch(pattern, arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = arnNormalizeFunction{}
+
+func NewARNNormalizeFunction() function.Function {
+	return &arnNormalizeFunction{}
+}
+
+type arnNormalizeFunction struct{}
+
+func (f arnNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_normalize"
+}
+
+func (f arnNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_normalize Function",
+		MarkdownDescription: "Returns the canonical form of an ARN, with the resource type separated from the resource identifier " +
+			"by a slash and any path removed from IAM resource identifiers",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f arnNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := inttypes.NormalizeARN(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_contains Function",
+		MarkdownDescription: "Checks whether a CIDR block fully contains another CIDR block or IP address",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "other",
+				MarkdownDescription: "CIDR block or IP address to test for containment",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, other string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &other))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	// a bare IP address is treated as a single-address CIDR block
+	if addr, err := netip.ParseAddr(other); err == nil {
+		other = netip.PrefixFrom(addr, addr.BitLen()).String()
+	}
+
+	if err := inttypes.ValidateCIDRBlock(other); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid CIDR block or IP address", other)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, inttypes.CIDRBlockContains(cidr, other)))
+}
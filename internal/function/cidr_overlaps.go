@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_overlaps Function",
+		MarkdownDescription: "Detects overlapping CIDR blocks in a list of IPv4 and IPv6 CIDR blocks. " +
+			"Returns every overlapping pair, ordered by position in the input list.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidr_blocks",
+				MarkdownDescription: "IPv4 and IPv6 CIDR blocks",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ListType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	for _, cidr := range cidrs {
+		if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+			return
+		}
+	}
+
+	result := make([][]string, 0)
+	for i := range cidrs {
+		for j := i + 1; j < len(cidrs); j++ {
+			if inttypes.CIDRBlocksIntersect(cidrs[i], cidrs[j]) {
+				result = append(result, []string{cidrs[i], cidrs[j]})
+			}
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDROverlapsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDROverlapsFunctionConfig(`["10.0.0.0/16", "10.1.0.0/16", "10.0.1.0/24", "2001:db8::/56", "2001:db8:0:1::/64"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[["10.0.0.0/16","10.0.1.0/24"],["2001:db8::/56","2001:db8:0:1::/64"]]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDROverlapsFunction_none(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDROverlapsFunctionConfig(`["10.0.0.0/24", "10.0.1.0/24", "0.0.0.0/0", "::/0"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[["10.0.0.0/24","0.0.0.0/0"],["10.0.1.0/24","0.0.0.0/0"]]`),
+				),
+			},
+			{
+				Config: testCIDROverlapsFunctionConfig(`["10.0.0.0/24", "10.0.1.0/24", "::/0"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `[]`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDROverlapsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDROverlapsFunctionConfig(`["10.0.0.0/24", "invalid"]`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDROverlapsFunctionConfig(cidrs string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_overlaps(%[1]s))
+}
+`, cidrs)
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrSubnetsForAZsFunction{}
+
+func NewCIDRSubnetsForAZsFunction() function.Function {
+	return &cidrSubnetsForAZsFunction{}
+}
+
+type cidrSubnetsForAZsFunction struct{}
+
+func (f cidrSubnetsForAZsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_for_azs"
+}
+
+func (f cidrSubnetsForAZsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_for_azs Function",
+		MarkdownDescription: "Carves a VPC CIDR block into one subnet per Availability Zone for each named tier. " +
+			"Returns a map of tier name to a map of Availability Zone to subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to carve",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones to create a subnet in for each tier",
+				ElementType:         types.StringType,
+			},
+			function.MapParameter{
+				Name:                "prefix_lengths",
+				MarkdownDescription: "Map of tier name (e.g. public, private, intra) to subnet prefix length",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{ElemType: types.StringType},
+		},
+	}
+}
+
+func (f cidrSubnetsForAZsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azs []string
+	var prefixLengths map[string]int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azs, &prefixLengths))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := inttypes.ValidateCIDRBlock(cidr); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	for i, az := range azs {
+		if slices.Contains(azs[:i], az) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("duplicate Availability Zone %q", az)))
+			return
+		}
+	}
+
+	parent, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := carveSubnetsForAZs(parent, azs, prefixLengths)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// carveSubnetsForAZs allocates one subnet per tier and Availability Zone from the parent prefix.
+// Subnets are packed from the start of the parent prefix, largest tiers first (ties broken by
+// tier name) and Availability Zones in the order given, so the result is deterministic.
+func carveSubnetsForAZs(parent netip.Prefix, azs []string, prefixLengths map[string]int64) (map[string]map[string]string, error) {
+	type tier struct {
+		name string
+		bits int
+	}
+
+	tiers := make([]tier, 0, len(prefixLengths))
+	for name, bits := range prefixLengths {
+		if bits < int64(parent.Bits()) || bits > int64(parent.Addr().BitLen()) {
+			return nil, fmt.Errorf("tier %q: prefix length %d must be between %d and %d", name, bits, parent.Bits(), parent.Addr().BitLen())
+		}
+		tiers = append(tiers, tier{name: name, bits: int(bits)})
+	}
+	slices.SortFunc(tiers, func(a, b tier) int {
+		return cmp.Or(cmp.Compare(a.bits, b.bits), cmp.Compare(a.name, b.name))
+	})
+
+	addrBits := parent.Addr().BitLen()
+	start := new(big.Int).SetBytes(parent.Addr().AsSlice())
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(addrBits-parent.Bits())))
+	next := new(big.Int).Set(start)
+
+	result := make(map[string]map[string]string, len(tiers))
+	for _, tier := range tiers {
+		size := new(big.Int).Lsh(big.NewInt(1), uint(addrBits-tier.bits))
+		result[tier.name] = make(map[string]string, len(azs))
+
+		for _, az := range azs {
+			// align the next free address to the subnet size
+			if rem := new(big.Int).Mod(next, size); rem.Sign() != 0 {
+				next.Add(next, new(big.Int).Sub(size, rem))
+			}
+
+			if new(big.Int).Add(next, size).Cmp(end) > 0 {
+				return nil, fmt.Errorf("CIDR block %s has insufficient space for tier %q in Availability Zone %q", parent, tier.name, az)
+			}
+
+			subnet := netip.PrefixFrom(bigIntToAddr(next, addrBits), tier.bits)
+			result[tier.name][az] = subnet.String()
+
+			next.Add(next, size)
+		}
+	}
+
+	return result, nil
+}
+
+func bigIntToAddr(v *big.Int, bits int) netip.Addr {
+	b := v.FillBytes(make([]byte, bits/8))
+	addr, _ := netip.AddrFromSlice(b)
+
+	return addr
+}
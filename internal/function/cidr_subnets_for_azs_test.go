@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsForAZsFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ public = 24, private = 20, intra = 26 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"intra":{"a":"10.0.51.0/26","b":"10.0.51.64/26","c":"10.0.51.128/26"},"private":{"a":"10.0.0.0/20","b":"10.0.16.0/20","c":"10.0.32.0/20"},"public":{"a":"10.0.48.0/24","b":"10.0.49.0/24","c":"10.0.50.0/24"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsForAZsFunctionConfig("2001:db8:0:100::/56", `{ public = 64, private = 64 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"private":{"a":"2001:db8:0:100::/64","b":"2001:db8:0:101::/64","c":"2001:db8:0:102::/64"},"public":{"a":"2001:db8:0:103::/64","b":"2001:db8:0:104::/64","c":"2001:db8:0:105::/64"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_insufficientSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/24", `{ public = 25 }`),
+				ExpectError: regexache.MustCompile(`insufficient[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsForAZsFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsForAZsFunctionConfig("10.0.0.0/16", `{ public = 8 }`),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*8[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsForAZsFunctionConfig(cidr, prefixLengths string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = jsonencode(provider::aws::cidr_subnets_for_azs(%[1]q, ["a", "b", "c"], %[2]s))
+}
+`, cidr, prefixLengths)
+}
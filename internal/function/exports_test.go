@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// Exports for use in tests only.
+
+// EvaluatePolicies returns the decision of evaluating a request against identity-based and resource-based policy documents.
+func EvaluatePolicies(principal, action, resource string, context map[string][]string, identityPolicies, resourcePolicies []string) (string, error) {
+	request := &policyRequest{
+		Principal: principal,
+		Action:    action,
+		Resource:  resource,
+		Context:   context,
+	}
+
+	evaluation, err := evaluatePolicyDocuments(request, identityPolicies, resourcePolicies)
+	if err != nil {
+		return "", err
+	}
+
+	return evaluation.Decision, nil
+}
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// partitionForRegion returns the partition containing the specified Region code.
+// Region codes not known to the provider are matched against each partition's Region naming pattern.
+func partitionForRegion(region string) (endpoints.Partition, error) {
+	if !inttypes.IsAWSRegion(region) {
+		return endpoints.Partition{}, fmt.Errorf("%q is not a valid AWS Region", region)
+	}
+
+	return names.PartitionForRegion(region), nil
+}
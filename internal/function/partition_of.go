@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var partitionOfResultAttrTypes = map[string]attr.Type{
+	"partition":          types.StringType,
+	"dns_suffix":         types.StringType,
+	"reverse_dns_prefix": types.StringType,
+}
+
+var _ function.Function = partitionOfFunction{}
+
+func NewPartitionOfFunction() function.Function {
+	return &partitionOfFunction{}
+}
+
+type partitionOfFunction struct{}
+
+func (f partitionOfFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "partition_of"
+}
+
+func (f partitionOfFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "partition_of Function",
+		MarkdownDescription: "Returns the partition containing a Region, along with the partition's domain suffix and reverse DNS prefix",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: partitionOfResultAttrTypes,
+		},
+	}
+}
+
+func (f partitionOfFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	dnsSuffix := names.DNSSuffixForPartition(partition)
+	value := map[string]attr.Value{
+		"partition":          types.StringValue(partition.ID()),
+		"dns_suffix":         types.StringValue(dnsSuffix),
+		"reverse_dns_prefix": types.StringValue(dns.Reverse(dnsSuffix)),
+	}
+
+	result, d := types.ObjectValue(partitionOfResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestPartitionOfFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPartitionOfFunctionConfig("us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-us-gov"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com"),
+					resource.TestCheckOutput("reverse_dns_prefix", "com.amazonaws"),
+				),
+			},
+			{
+				Config: testPartitionOfFunctionConfig("cn-northwest-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("partition", "aws-cn"),
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com.cn"),
+					resource.TestCheckOutput("reverse_dns_prefix", "cn.com.amazonaws"),
+				),
+			},
+		},
+	})
+}
+
+func testPartitionOfFunctionConfig(region string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::partition_of(%[1]q)
+}
+
+output "partition" {
+  value = local.result.partition
+}
+
+output "dns_suffix" {
+  value = local.result.dns_suffix
+}
+
+output "reverse_dns_prefix" {
+  value = local.result.reverse_dns_prefix
+}
+`, region)
+}
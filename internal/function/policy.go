@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+const (
+	// policyVersion is the current IAM policy language version
+	policyVersion = "2012-10-17"
+
+	// policyWildcard matches all principals when used as the Principal element
+	policyWildcard = "*"
+)
+
+// policyDocument is a minimal IAM policy document model shared by the policy functions.
+type policyDocument struct {
+	Version    string           `json:",omitempty"`
+	ID         string           `json:"Id,omitempty"`
+	Statements policyStatements `json:"Statement"`
+}
+
+type policyStatements []*policyStatement
+
+type policyStatement struct {
+	Sid          string           `json:",omitempty"`
+	Effect       string           `json:",omitempty"`
+	Principal    *policyPrincipal `json:",omitempty"`
+	NotPrincipal *policyPrincipal `json:",omitempty"`
+	Action       policyStringSet  `json:",omitempty"`
+	NotAction    policyStringSet  `json:",omitempty"`
+	Resource     policyStringSet  `json:",omitempty"`
+	NotResource  policyStringSet  `json:",omitempty"`
+	Condition    policyConditions `json:",omitempty"`
+}
+
+// policyPrincipal is either the "*" wildcard or a map of principal type to identifiers.
+type policyPrincipal struct {
+	Wildcard bool
+	Values   map[string]policyStringSet
+}
+
+// policyConditions maps condition operator to condition key to values.
+type policyConditions map[string]map[string]policyStringSet
+
+// policyStringSet is a policy element that may be expressed as a single string or a list of strings.
+type policyStringSet []string
+
+// parsePolicyDocument unmarshals an IAM policy JSON string.
+func parsePolicyDocument(s string) (*policyDocument, error) {
+	var doc policyDocument
+
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	return &doc, nil
+}
+
+// normalize sorts and deduplicates every set-valued element in the statement.
+func (s *policyStatement) normalize() {
+	s.Action = s.Action.normalize()
+	s.NotAction = s.NotAction.normalize()
+	s.Resource = s.Resource.normalize()
+	s.NotResource = s.NotResource.normalize()
+	s.Principal.normalize()
+	s.NotPrincipal.normalize()
+
+	for _, vars := range s.Condition {
+		for k, v := range vars {
+			vars[k] = v.normalize()
+		}
+	}
+}
+
+// String returns the statement wrapped in a single-statement policy document.
+func (s *policyStatement) String() string {
+	doc := policyDocument{
+		Version:    policyVersion,
+		Statements: policyStatements{s},
+	}
+
+	b, err := json.Marshal(&doc)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+func (ss *policyStatements) UnmarshalJSON(b []byte) error {
+	var list []*policyStatement
+	if err := json.Unmarshal(b, &list); err == nil {
+		*ss = list
+		return nil
+	}
+
+	var single policyStatement
+	if err := json.Unmarshal(b, &single); err != nil {
+		return err
+	}
+
+	*ss = policyStatements{&single}
+	return nil
+}
+
+func (p *policyPrincipal) normalize() {
+	if p == nil {
+		return
+	}
+
+	for k, v := range p.Values {
+		p.Values[k] = v.normalize()
+	}
+}
+
+func (p policyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.Wildcard {
+		return json.Marshal(policyWildcard)
+	}
+
+	return json.Marshal(p.Values)
+}
+
+func (p *policyPrincipal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != policyWildcard {
+			return fmt.Errorf("unsupported principal %q", s)
+		}
+		*p = policyPrincipal{Wildcard: true}
+		return nil
+	}
+
+	var values map[string]policyStringSet
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+
+	*p = policyPrincipal{Values: values}
+	return nil
+}
+
+func (ss policyStringSet) normalize() policyStringSet {
+	if len(ss) == 0 {
+		return ss
+	}
+
+	ss = slices.Clone(ss)
+	slices.Sort(ss)
+
+	return slices.Compact(ss)
+}
+
+func (ss policyStringSet) MarshalJSON() ([]byte, error) {
+	if len(ss) == 1 {
+		return json.Marshal(ss[0])
+	}
+
+	return json.Marshal([]string(ss))
+}
+
+func (ss *policyStringSet) UnmarshalJSON(b []byte) error {
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	var out policyStringSet
+	switch v := data.(type) {
+	case []any:
+		for _, e := range v {
+			s, err := policyScalarString(e)
+			if err != nil {
+				return err
+			}
+			out = append(out, s)
+		}
+	default:
+		s, err := policyScalarString(v)
+		if err != nil {
+			return err
+		}
+		out = append(out, s)
+	}
+
+	*ss = out
+	return nil
+}
+
+// policyScalarString converts a JSON scalar to its IAM string representation.
+// Condition values may be written as booleans or numbers.
+func policyScalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported data type %T in policy element", v)
+	}
+}
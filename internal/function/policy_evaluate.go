@@ -0,0 +1,574 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	policyDecisionAllow        = "allow"
+	policyDecisionExplicitDeny = "explicit_deny"
+	policyDecisionImplicitDeny = "implicit_deny"
+
+	policyTypeIdentity = "identity"
+	policyTypeResource = "resource"
+
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+)
+
+var policyEvaluateResultAttrTypes = map[string]attr.Type{
+	"decision":        types.StringType,
+	"policy_type":     types.StringType,
+	"policy_index":    types.Int64Type,
+	"statement_index": types.Int64Type,
+	"sid":             types.StringType,
+	"statement":       types.StringType,
+}
+
+var _ function.Function = policyEvaluateFunction{}
+
+func NewPolicyEvaluateFunction() function.Function {
+	return &policyEvaluateFunction{}
+}
+
+type policyEvaluateFunction struct{}
+
+func (f policyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_evaluate"
+}
+
+func (f policyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_evaluate Function",
+		MarkdownDescription: "Evaluates a request against identity-based and resource-based IAM policy JSON documents without calling AWS. " +
+			"Returns whether the request is allowed, explicitly denied or implicitly denied, and the statement that decided it.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "principal",
+				MarkdownDescription: "ARN of the principal making the request",
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action being requested, e.g. s3:GetObject",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource being requested",
+			},
+			function.MapParameter{
+				Name:                "context",
+				MarkdownDescription: "Request context keys and their values, used to evaluate policy conditions",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+			function.ListParameter{
+				Name:                "identity_policies",
+				MarkdownDescription: "Identity-based IAM policy JSON documents attached to the principal",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "resource_policies",
+				MarkdownDescription: "Resource-based IAM policy JSON documents attached to the resource",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: policyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f policyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var principal, action, resource string
+	var requestContext map[string][]string
+	var identityPolicies, resourcePolicies []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &principal, &action, &resource, &requestContext, &identityPolicies, &resourcePolicies))
+	if resp.Error != nil {
+		return
+	}
+
+	request := &policyRequest{
+		Principal: principal,
+		Action:    action,
+		Resource:  resource,
+		Context:   requestContext,
+	}
+
+	evaluation, funcErr := evaluatePolicyDocuments(request, identityPolicies, resourcePolicies)
+	if funcErr != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+		return
+	}
+
+	value := map[string]attr.Value{
+		"decision":        types.StringValue(evaluation.Decision),
+		"policy_type":     types.StringNull(),
+		"policy_index":    types.Int64Null(),
+		"statement_index": types.Int64Null(),
+		"sid":             types.StringNull(),
+		"statement":       types.StringNull(),
+	}
+	if s := evaluation.Statement; s != nil {
+		statement, err := json.Marshal(s)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+
+		value["policy_type"] = types.StringValue(evaluation.PolicyType)
+		value["policy_index"] = types.Int64Value(int64(evaluation.PolicyIndex))
+		value["statement_index"] = types.Int64Value(int64(evaluation.StatementIndex))
+		value["sid"] = types.StringValue(s.Sid)
+		value["statement"] = types.StringValue(string(statement))
+	}
+
+	result, d := types.ObjectValue(policyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// evaluatePolicyDocuments parses the identity-based and resource-based policy documents and evaluates the request against them.
+func evaluatePolicyDocuments(request *policyRequest, identityPolicies, resourcePolicies []string) (*policyEvaluation, *function.FuncError) {
+	var policies []policyEvaluationInput
+	for i, policy := range identityPolicies {
+		doc, err := parsePolicyDocument(policy)
+		if err != nil {
+			return nil, function.NewArgumentFuncError(4, fmt.Sprintf("policy %d: %s", i, err))
+		}
+		policies = append(policies, policyEvaluationInput{Type: policyTypeIdentity, Index: i, Document: doc})
+	}
+	for i, policy := range resourcePolicies {
+		doc, err := parsePolicyDocument(policy)
+		if err != nil {
+			return nil, function.NewArgumentFuncError(5, fmt.Sprintf("policy %d: %s", i, err))
+		}
+		policies = append(policies, policyEvaluationInput{Type: policyTypeResource, Index: i, Document: doc})
+	}
+
+	evaluation, err := evaluatePolicies(request, policies)
+	if err != nil {
+		return nil, function.NewFuncError(err.Error())
+	}
+
+	return evaluation, nil
+}
+
+// policyRequest is the request tuple being evaluated.
+type policyRequest struct {
+	Principal string
+	Action    string
+	Resource  string
+	Context   map[string][]string
+}
+
+type policyEvaluationInput struct {
+	Type     string
+	Index    int
+	Document *policyDocument
+}
+
+type policyEvaluation struct {
+	Decision       string
+	PolicyType     string
+	PolicyIndex    int
+	StatementIndex int
+	Statement      *policyStatement
+}
+
+// evaluatePolicies applies the IAM evaluation logic for a principal and resource in the same account:
+// an explicit deny in any policy wins, otherwise an allow in any policy grants access.
+// Organizations SCPs, permissions boundaries and session policies are not considered.
+func evaluatePolicies(request *policyRequest, policies []policyEvaluationInput) (*policyEvaluation, error) {
+	var allow *policyEvaluation
+
+	for _, policy := range policies {
+		for i, statement := range policy.Document.Statements {
+			ok, err := statement.matches(request, policy.Type == policyTypeResource)
+			if err != nil {
+				return nil, fmt.Errorf("%s policy %d, statement %d: %w", policy.Type, policy.Index, i, err)
+			}
+			if !ok {
+				continue
+			}
+
+			evaluation := &policyEvaluation{
+				PolicyType:     policy.Type,
+				PolicyIndex:    policy.Index,
+				StatementIndex: i,
+				Statement:      statement,
+			}
+
+			switch statement.Effect {
+			case policyEffectDeny:
+				evaluation.Decision = policyDecisionExplicitDeny
+				return evaluation, nil
+			case policyEffectAllow:
+				if allow == nil {
+					evaluation.Decision = policyDecisionAllow
+					allow = evaluation
+				}
+			default:
+				return nil, fmt.Errorf("%s policy %d, statement %d: unsupported Effect %q", policy.Type, policy.Index, i, statement.Effect)
+			}
+		}
+	}
+
+	if allow != nil {
+		return allow, nil
+	}
+
+	return &policyEvaluation{Decision: policyDecisionImplicitDeny}, nil
+}
+
+// matches returns whether the statement applies to the request.
+// Principal elements are only considered for resource-based policies.
+func (s *policyStatement) matches(request *policyRequest, resourcePolicy bool) (bool, error) {
+	if resourcePolicy {
+		switch {
+		case s.Principal != nil:
+			if !s.Principal.matches(request.Principal) {
+				return false, nil
+			}
+		case s.NotPrincipal != nil:
+			if s.NotPrincipal.matches(request.Principal) {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+
+	switch {
+	case len(s.Action) > 0:
+		if !policyAnyMatch(s.Action, request.Action, true) {
+			return false, nil
+		}
+	case len(s.NotAction) > 0:
+		if policyAnyMatch(s.NotAction, request.Action, true) {
+			return false, nil
+		}
+	default:
+		return false, nil
+	}
+
+	switch {
+	case len(s.Resource) > 0:
+		if !policyAnyMatch(request.substituteVariables(s.Resource), request.Resource, false) {
+			return false, nil
+		}
+	case len(s.NotResource) > 0:
+		if policyAnyMatch(request.substituteVariables(s.NotResource), request.Resource, false) {
+			return false, nil
+		}
+	default:
+		// resource-based policies may omit Resource, implying the resource the policy is attached to
+		if !resourcePolicy {
+			return false, nil
+		}
+	}
+
+	for operator, conditions := range s.Condition {
+		for key, values := range conditions {
+			ok, err := request.evaluateCondition(operator, key, request.substituteVariables(values))
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// matches returns whether the principal element identifies the requesting principal.
+func (p *policyPrincipal) matches(principal string) bool {
+	if p.Wildcard {
+		return true
+	}
+
+	var accountID string
+	if v, err := arn.Parse(principal); err == nil {
+		accountID = v.AccountID
+	}
+
+	for typ, identifiers := range p.Values {
+		for _, identifier := range identifiers {
+			switch {
+			case identifier == policyWildcard, identifier == principal:
+				return true
+			case typ == "AWS" && accountID != "":
+				if identifier == accountID {
+					return true
+				}
+				if v, err := arn.Parse(identifier); err == nil && v.Service == "iam" && v.Resource == "root" && v.AccountID == accountID {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+var policyVariableRegexp = regexache.MustCompile(`\$\{([^}]+)\}`)
+
+// substituteVariables replaces policy variables such as ${aws:username} with
+// their single value from the request context.
+func (r *policyRequest) substituteVariables(values policyStringSet) policyStringSet {
+	out := make(policyStringSet, 0, len(values))
+
+	for _, v := range values {
+		out = append(out, policyVariableRegexp.ReplaceAllStringFunc(v, func(m string) string {
+			key, def, hasDefault := strings.Cut(policyVariableRegexp.FindStringSubmatch(m)[1], ",")
+			key = strings.TrimSpace(key)
+
+			switch key {
+			case "*", "?", "$":
+				// escaped special characters match literally
+				return key
+			}
+
+			if values, ok := r.contextValues(key); ok && len(values) == 1 {
+				return values[0]
+			}
+
+			if hasDefault {
+				return strings.Trim(strings.TrimSpace(def), "'")
+			}
+
+			return m
+		}))
+	}
+
+	return out
+}
+
+// contextValues looks up a condition key case-insensitively, as IAM does.
+func (r *policyRequest) contextValues(key string) ([]string, bool) {
+	for k, v := range r.Context {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// evaluateCondition evaluates a single condition operator and key against the request context.
+func (r *policyRequest) evaluateCondition(operator, key string, values policyStringSet) (bool, error) {
+	contextValues, present := r.contextValues(key)
+	if present && len(contextValues) == 0 {
+		present = false
+	}
+
+	base := operator
+	var forAnyValue, forAllValues, ifExists bool
+	if v, ok := strings.CutPrefix(base, "ForAnyValue:"); ok {
+		base, forAnyValue = v, true
+	} else if v, ok := strings.CutPrefix(base, "ForAllValues:"); ok {
+		base, forAllValues = v, true
+	}
+	if v, ok := strings.CutSuffix(base, "IfExists"); ok && base != "Null" {
+		base, ifExists = v, true
+	}
+
+	if base == "Null" {
+		if len(values) != 1 {
+			return false, fmt.Errorf("condition operator %q requires a single value", operator)
+		}
+		null, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return false, fmt.Errorf("condition operator %q: %w", operator, err)
+		}
+		return null != present, nil
+	}
+
+	match, negated, err := policyConditionOperator(base)
+	if err != nil {
+		return false, err
+	}
+
+	matchOne := func(contextValue string) (bool, error) {
+		for _, v := range values {
+			ok, err := match(contextValue, v)
+			if err != nil {
+				return false, fmt.Errorf("condition operator %q: %w", operator, err)
+			}
+			if ok {
+				return !negated, nil
+			}
+		}
+		return negated, nil
+	}
+
+	switch {
+	case forAllValues:
+		// vacuously true when the key is missing
+		for _, v := range contextValues {
+			if ok, err := matchOne(v); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case !present:
+		return ifExists || (negated && !forAnyValue), nil
+	case negated && !forAnyValue:
+		// a negated operator matches only when no context value matches
+		for _, v := range contextValues {
+			if ok, err := matchOne(v); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		for _, v := range contextValues {
+			if ok, err := matchOne(v); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+type policyConditionMatchFunc func(contextValue, policyValue string) (bool, error)
+
+// policyConditionOperator returns the matcher for a base condition operator
+// and whether the operator is negated.
+func policyConditionOperator(operator string) (policyConditionMatchFunc, bool, error) {
+	switch operator {
+	case "StringEquals", "StringNotEquals":
+		return func(c, p string) (bool, error) { return c == p, nil }, operator == "StringNotEquals", nil
+	case "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase":
+		return func(c, p string) (bool, error) { return strings.EqualFold(c, p), nil }, operator == "StringNotEqualsIgnoreCase", nil
+	case "StringLike", "StringNotLike":
+		return func(c, p string) (bool, error) { return policyWildcardMatch(p, c, false), nil }, operator == "StringNotLike", nil
+	case "ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike":
+		return func(c, p string) (bool, error) { return policyARNMatch(p, c), nil }, strings.HasPrefix(operator, "ArnNot"), nil
+	case "Bool":
+		return func(c, p string) (bool, error) { return strings.EqualFold(c, p), nil }, false, nil
+	case "BinaryEquals":
+		return func(c, p string) (bool, error) { return c == p, nil }, false, nil
+	case "IpAddress", "NotIpAddress":
+		return policyIPAddressMatch, operator == "NotIpAddress", nil
+	case "NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan", "NumericGreaterThanEquals":
+		return policyCompareMatch(operator, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }), operator == "NumericNotEquals", nil
+	case "DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals":
+		return policyCompareMatch(operator, policyParseDate), operator == "DateNotEquals", nil
+	default:
+		return nil, false, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+}
+
+// policyCompareMatch returns a matcher that orders context and policy values.
+func policyCompareMatch(operator string, parse func(string) (float64, error)) policyConditionMatchFunc {
+	return func(c, p string) (bool, error) {
+		cv, err := parse(c)
+		if err != nil {
+			return false, err
+		}
+		pv, err := parse(p)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case strings.HasSuffix(operator, "LessThan"):
+			return cv < pv, nil
+		case strings.HasSuffix(operator, "LessThanEquals"):
+			return cv <= pv, nil
+		case strings.HasSuffix(operator, "GreaterThan"):
+			return cv > pv, nil
+		case strings.HasSuffix(operator, "GreaterThanEquals"):
+			return cv >= pv, nil
+		default:
+			return cv == pv, nil
+		}
+	}
+}
+
+// policyParseDate parses an ISO 8601 date or epoch seconds.
+func policyParseDate(s string) (float64, error) {
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return v, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return float64(t.Unix()), nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid date %q", s)
+}
+
+func policyIPAddressMatch(c, p string) (bool, error) {
+	addr, err := netip.ParseAddr(c)
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address %q", c)
+	}
+
+	if !strings.Contains(p, "/") {
+		v, err := netip.ParseAddr(p)
+		if err != nil {
+			return false, fmt.Errorf("invalid IP address %q", p)
+		}
+		return v == addr, nil
+	}
+
+	prefix, err := netip.ParsePrefix(p)
+	if err != nil {
+		return false, fmt.Errorf("invalid CIDR block %q", p)
+	}
+
+	return prefix.Contains(addr), nil
+}
+
+// policyAnyMatch returns whether any wildcard pattern matches s.
+func policyAnyMatch(patterns policyStringSet, s string, ignoreCase bool) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		return policyWildcardMatch(pattern, s, ignoreCase)
+	})
+}
+
+// policyARNMatch matches an ARN against a pattern one colon-separated section at a time.
+func policyARNMatch(pattern, s string) bool {
+	match, err := inttypes.ARNMatch(pattern, s)
+	if err != nil {
+		return inttypes.WildcardMatch(pattern, s)
+	}
+
+	return match
+}
+
+// policyWildcardMatch matches s against an IAM pattern in which '*' matches any
+// sequence of characters and '?' matches any single character.
+func policyWildcardMatch(pattern, s string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern, s = strings.ToLower(pattern), strings.ToLower(s)
+	}
+
+	return inttypes.WildcardMatch(pattern, s)
+}
@@ -0,0 +1,553 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+const (
+	testPolicyEvaluateIdentityPolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:Get*","Resource":"arn:aws:s3:::example/${aws:username}/*"},{"Sid":"DenyInsecure","Effect":"Deny","NotAction":"iam:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`
+	testPolicyEvaluateResourcePolicy = `{"Version":"2012-10-17","Statement":[{"Sid":"Write","Effect":"Allow","Principal":{"AWS":"444455556666"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::example/*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`
+)
+
+func TestPolicyEvaluateFunction_allowIdentity(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/alice/data.csv", `{ "aws:username" = ["alice"], "aws:SecureTransport" = ["true"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "allow"),
+					resource.TestCheckOutput("sid", "Read"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyEvaluateFunction_allowResource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyEvaluateFunctionConfig("s3:PutObject", "arn:aws:s3:::example/alice/data.csv", `{ "aws:SourceIp" = ["10.1.2.3"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "allow"),
+					resource.TestCheckOutput("sid", "Write"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyEvaluateFunction_explicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::example/alice/data.csv", `{ "aws:username" = ["alice"], "aws:SecureTransport" = ["false"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "explicit_deny"),
+					resource.TestCheckOutput("sid", "DenyInsecure"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyEvaluateFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testPolicyEvaluateFunctionConfig("s3:PutObject", "arn:aws:s3:::example/alice/data.csv", `{ "aws:SourceIp" = ["192.0.2.1"] }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "implicit_deny"),
+				),
+			},
+		},
+	})
+}
+
+func TestPolicyEvaluateFunction_unsupportedOperator(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+output "decision" {
+  value = provider::aws::policy_evaluate("arn:aws:iam::444455556666:role/example", "s3:GetObject", "*", {}, [%[1]q], []).decision
+}
+`, `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSounds":{"aws:username":"x"}}}]}`),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*condition[\s\n]*operator`),
+			},
+		},
+	})
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		principal = "arn:aws:iam::444455556666:user/alice"
+		action    = "s3:GetObject"
+		resource  = "arn:aws:s3:::example/alice/data.csv"
+	)
+
+	testCases := map[string]struct {
+		statement        string
+		resourcePolicy   bool
+		context          map[string][]string
+		expectedDecision string
+		expectError      bool
+	}{
+		// Action and Resource.
+		"wildcard Action": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*"}`,
+			expectedDecision: "allow",
+		},
+		"wildcard Action prefix": {
+			statement:        `{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}`,
+			expectedDecision: "allow",
+		},
+		"wildcard Action prefix no match": {
+			statement:        `{"Effect":"Allow","Action":"s3:Put*","Resource":"*"}`,
+			expectedDecision: "implicit_deny",
+		},
+		"wildcard Action single character": {
+			statement:        `{"Effect":"Allow","Action":"s3:Get?bject","Resource":"*"}`,
+			expectedDecision: "allow",
+		},
+		"Action case insensitive": {
+			statement:        `{"Effect":"Allow","Action":"S3:getobject","Resource":"*"}`,
+			expectedDecision: "allow",
+		},
+		"wildcard Resource": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}`,
+			expectedDecision: "allow",
+		},
+		"wildcard Resource no match": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::other/*"}`,
+			expectedDecision: "implicit_deny",
+		},
+		"Resource case sensitive": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::EXAMPLE/*"}`,
+			expectedDecision: "implicit_deny",
+		},
+		"Resource policy variable": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/${aws:username}/*"}`,
+			context:          map[string][]string{"aws:username": {"alice"}},
+			expectedDecision: "allow",
+		},
+		"Resource policy variable missing key": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/${aws:username}/*"}`,
+			expectedDecision: "implicit_deny",
+		},
+		"NotAction": {
+			statement:        `{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}`,
+			expectedDecision: "allow",
+		},
+		"NotAction match": {
+			statement:        `{"Effect":"Allow","NotAction":"s3:*","Resource":"*"}`,
+			expectedDecision: "implicit_deny",
+		},
+		"NotAction Deny": {
+			statement:        `{"Effect":"Deny","NotAction":"iam:*","Resource":"*"}`,
+			expectedDecision: "explicit_deny",
+		},
+		"NotResource": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::other/*"}`,
+			expectedDecision: "allow",
+		},
+		"NotResource match": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::example/*"}`,
+			expectedDecision: "implicit_deny",
+		},
+
+		// Principal.
+		"resource policy account principal": {
+			statement:        `{"Effect":"Allow","Principal":{"AWS":"444455556666"},"Action":"s3:GetObject"}`,
+			resourcePolicy:   true,
+			expectedDecision: "allow",
+		},
+		"resource policy root principal": {
+			statement:        `{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::444455556666:root"},"Action":"s3:GetObject"}`,
+			resourcePolicy:   true,
+			expectedDecision: "allow",
+		},
+		"resource policy other principal": {
+			statement:        `{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"s3:GetObject"}`,
+			resourcePolicy:   true,
+			expectedDecision: "implicit_deny",
+		},
+		"resource policy NotPrincipal": {
+			statement:        `{"Effect":"Deny","NotPrincipal":{"AWS":"111122223333"},"Action":"s3:GetObject"}`,
+			resourcePolicy:   true,
+			expectedDecision: "explicit_deny",
+		},
+		"resource policy no principal": {
+			statement:        `{"Effect":"Allow","Action":"s3:GetObject"}`,
+			resourcePolicy:   true,
+			expectedDecision: "implicit_deny",
+		},
+
+		// String operators.
+		"StringEquals": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"data"}}}`,
+			context:          map[string][]string{"aws:PrincipalTag/team": {"data"}},
+			expectedDecision: "allow",
+		},
+		"StringEquals case insensitive key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"AWS:PrincipalTag/Team":"data"}}}`,
+			context:          map[string][]string{"aws:principaltag/team": {"data"}},
+			expectedDecision: "allow",
+		},
+		"StringEquals missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"data"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"StringLike": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/*"}}}`,
+			context:          map[string][]string{"s3:prefix": {"home/alice"}},
+			expectedDecision: "allow",
+		},
+		"StringLike single character": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/?"}}}`,
+			context:          map[string][]string{"s3:prefix": {"home/alice"}},
+			expectedDecision: "implicit_deny",
+		},
+		"StringLike no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/*"}}}`,
+			context:          map[string][]string{"s3:prefix": {"shared/alice"}},
+			expectedDecision: "implicit_deny",
+		},
+		"StringLike missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"s3:prefix":"home/*"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"StringNotLike": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringNotLike":{"s3:prefix":"home/*"}}}`,
+			context:          map[string][]string{"s3:prefix": {"home/alice"}},
+			expectedDecision: "implicit_deny",
+		},
+		"StringNotLike missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringNotLike":{"s3:prefix":"home/*"}}}`,
+			expectedDecision: "allow",
+		},
+		"StringNotEquals missing key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:PrincipalOrgID":"o-example"}}}`,
+			expectedDecision: "explicit_deny",
+		},
+		"StringNotEquals multiple values": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]}}}`,
+			context:          map[string][]string{"aws:RequestedRegion": {"us-west-2"}},
+			expectedDecision: "implicit_deny",
+		},
+
+		// ARN operators.
+		"ArnLike": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:444455556666:*"}}}`,
+			context:          map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:444455556666:example"}},
+			expectedDecision: "allow",
+		},
+		"ArnLike no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:111122223333:*"}}}`,
+			context:          map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:444455556666:example"}},
+			expectedDecision: "implicit_deny",
+		},
+		"ArnLike missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnLike":{"aws:SourceArn":"arn:aws:sns:*:444455556666:*"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"ArnNotLike missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ArnNotLike":{"aws:SourceArn":"arn:aws:sns:*:444455556666:*"}}}`,
+			expectedDecision: "allow",
+		},
+
+		// IP address operators.
+		"IpAddress": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":["192.0.2.0/24","10.0.0.0/8"]}}}`,
+			context:          map[string][]string{"aws:SourceIp": {"10.1.2.3"}},
+			expectedDecision: "allow",
+		},
+		"IpAddress single address": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"10.1.2.3"}}}`,
+			context:          map[string][]string{"aws:SourceIp": {"10.1.2.3"}},
+			expectedDecision: "allow",
+		},
+		"IpAddress IPv6": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"2001:db8::/32"}}}`,
+			context:          map[string][]string{"aws:SourceIp": {"2001:db8::1"}},
+			expectedDecision: "allow",
+		},
+		"IpAddress no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			context:          map[string][]string{"aws:SourceIp": {"192.0.2.1"}},
+			expectedDecision: "implicit_deny",
+		},
+		"IpAddress missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"IpAddress invalid context value": {
+			statement:   `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			context:     map[string][]string{"aws:SourceIp": {"example.com"}},
+			expectError: true,
+		},
+		"NotIpAddress": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			context:          map[string][]string{"aws:SourceIp": {"192.0.2.1"}},
+			expectedDecision: "explicit_deny",
+		},
+		"NotIpAddress missing key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"NotIpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			expectedDecision: "explicit_deny",
+		},
+
+		// Bool operator.
+		"Bool true": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}`,
+			context:          map[string][]string{"aws:SecureTransport": {"FALSE"}},
+			expectedDecision: "explicit_deny",
+		},
+		"Bool false": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}`,
+			context:          map[string][]string{"aws:SecureTransport": {"true"}},
+			expectedDecision: "implicit_deny",
+		},
+		"Bool missing key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+
+		// Null operator.
+		"Null true missing key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"true"}}}`,
+			expectedDecision: "explicit_deny",
+		},
+		"Null true present key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"true"}}}`,
+			context:          map[string][]string{"aws:MultiFactorAuthAge": {"300"}},
+			expectedDecision: "implicit_deny",
+		},
+		"Null false missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"false"}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"Null false present key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"false"}}}`,
+			context:          map[string][]string{"aws:MultiFactorAuthAge": {"300"}},
+			expectedDecision: "allow",
+		},
+		"Null empty context value": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"true"}}}`,
+			context:          map[string][]string{"aws:MultiFactorAuthAge": {}},
+			expectedDecision: "explicit_deny",
+		},
+		"Null invalid value": {
+			statement:   `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Null":{"aws:MultiFactorAuthAge":"maybe"}}}`,
+			expectError: true,
+		},
+
+		// Set operators.
+		"ForAnyValue match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"owner", "team"}},
+			expectedDecision: "allow",
+		},
+		"ForAnyValue no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"owner"}},
+			expectedDecision: "implicit_deny",
+		},
+		"ForAnyValue missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"ForAnyValue negated": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringNotEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team", "owner"}},
+			expectedDecision: "allow",
+		},
+		"ForAnyValue negated no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringNotEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team"}},
+			expectedDecision: "implicit_deny",
+		},
+		"ForAnyValue negated missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAnyValue:StringNotEquals":{"aws:TagKeys":["team","project"]}}}`,
+			expectedDecision: "implicit_deny",
+		},
+		"ForAllValues match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"project", "team"}},
+			expectedDecision: "allow",
+		},
+		"ForAllValues no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team", "owner"}},
+			expectedDecision: "implicit_deny",
+		},
+		"ForAllValues missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringEquals":{"aws:TagKeys":["team","project"]}}}`,
+			expectedDecision: "allow",
+		},
+		"ForAllValues StringLike": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringLike":{"aws:TagKeys":"team*"}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team", "teamlead"}},
+			expectedDecision: "allow",
+		},
+		"ForAllValues negated": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringNotEquals":{"aws:TagKeys":["secret"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team", "owner"}},
+			expectedDecision: "allow",
+		},
+		"ForAllValues negated no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringNotEquals":{"aws:TagKeys":["secret"]}}}`,
+			context:          map[string][]string{"aws:TagKeys": {"team", "secret"}},
+			expectedDecision: "implicit_deny",
+		},
+		"ForAllValues negated missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"ForAllValues:StringNotEquals":{"aws:TagKeys":["secret"]}}}`,
+			expectedDecision: "allow",
+		},
+
+		// IfExists.
+		"IfExists match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`,
+			context:          map[string][]string{"ec2:InstanceType": {"t3.micro"}},
+			expectedDecision: "allow",
+		},
+		"IfExists no match": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`,
+			context:          map[string][]string{"ec2:InstanceType": {"m5.large"}},
+			expectedDecision: "implicit_deny",
+		},
+		"IfExists missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`,
+			expectedDecision: "allow",
+		},
+		"IfExists negated missing key": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`,
+			expectedDecision: "explicit_deny",
+		},
+		"IfExists negated match": {
+			statement:        `{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEqualsIfExists":{"ec2:InstanceType":"t3.micro"}}}`,
+			context:          map[string][]string{"ec2:InstanceType": {"t3.micro"}},
+			expectedDecision: "implicit_deny",
+		},
+		"IfExists Bool missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"BoolIfExists":{"aws:MultiFactorAuthPresent":"true"}}}`,
+			expectedDecision: "allow",
+		},
+		"IfExists IpAddress missing key": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"IpAddressIfExists":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			expectedDecision: "allow",
+		},
+
+		// Numeric and date operators.
+		"NumericLessThan": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":"3600"}}}`,
+			context:          map[string][]string{"aws:MultiFactorAuthAge": {"300"}},
+			expectedDecision: "allow",
+		},
+		"DateGreaterThan": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"DateGreaterThan":{"aws:CurrentTime":"2020-01-01T00:00:00Z"}}}`,
+			context:          map[string][]string{"aws:CurrentTime": {"2026-01-01T00:00:00Z"}},
+			expectedDecision: "allow",
+		},
+
+		// Multiple conditions are ANDed.
+		"multiple conditions": {
+			statement:        `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"data"},"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}`,
+			context:          map[string][]string{"aws:PrincipalTag/team": {"data"}, "aws:SourceIp": {"192.0.2.1"}},
+			expectedDecision: "implicit_deny",
+		},
+		"unsupported operator": {
+			statement:   `{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringSounds":{"aws:username":"x"}}}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := `{"Version":"2012-10-17","Statement":[` + testCase.statement + `]}`
+			var identityPolicies, resourcePolicies []string
+			if testCase.resourcePolicy {
+				resourcePolicies = append(resourcePolicies, policy)
+			} else {
+				identityPolicies = append(identityPolicies, policy)
+			}
+
+			decision, err := tffunction.EvaluatePolicies(principal, action, resource, testCase.context, identityPolicies, resourcePolicies)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("EvaluatePolicies() err = %v, expectError = %t", err, want)
+			}
+
+			if got, want := decision, testCase.expectedDecision; got != want {
+				t.Errorf("EvaluatePolicies() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func testPolicyEvaluateFunctionConfig(action, resource, context string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::policy_evaluate(
+    "arn:aws:iam::444455556666:user/alice",
+    %[1]q,
+    %[2]q,
+    %[3]s,
+    [%[4]q],
+    [%[5]q],
+  )
+}
+
+output "decision" {
+  value = local.result.decision
+}
+
+output "sid" {
+  value = coalesce(local.result.sid, "none")
+}
+`, action, resource, context, strings.ReplaceAll(testPolicyEvaluateIdentityPolicy, "${", "$${"), testPolicyEvaluateResourcePolicy)
+}
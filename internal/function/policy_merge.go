@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyMergeFunction{}
+
+func NewPolicyMergeFunction() function.Function {
+	return &policyMergeFunction{}
+}
+
+type policyMergeFunction struct{}
+
+func (f policyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_merge"
+}
+
+func (f policyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy JSON documents into a single normalized document. " +
+			"Statements are deduplicated by Sid, and statements differing only in their actions or resources are combined.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy JSON documents to merge",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	docs := make([]*policyDocument, 0, len(args))
+	for i, arg := range args {
+		doc, err := parsePolicyDocument(arg)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("policy %d: %s", i, err)))
+			return
+		}
+		docs = append(docs, doc)
+	}
+
+	merged, err := mergePolicyDocuments(docs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := json.Marshal(merged)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
+
+// mergePolicyDocuments combines policy documents in order.
+// Statements sharing a Sid must be equivalent; statements without a Sid are
+// dropped when equivalent to an earlier statement, and combined with an
+// earlier statement when only their Action or Resource elements differ.
+func mergePolicyDocuments(docs []*policyDocument) (*policyDocument, error) {
+	merged := &policyDocument{}
+	sids := make(map[string]*policyStatement)
+
+	for _, doc := range docs {
+		// adopt the latest Id and let later documents upgrade the Version
+		if doc.ID != "" {
+			merged.ID = doc.ID
+		}
+		if doc.Version > merged.Version {
+			merged.Version = doc.Version
+		}
+
+	statements:
+		for _, statement := range doc.Statements {
+			statement.normalize()
+
+			if statement.Sid != "" {
+				if existing, ok := sids[statement.Sid]; ok {
+					if !verify.PolicyStringsEquivalent(existing.String(), statement.String()) {
+						return nil, fmt.Errorf("conflicting statements with Sid %q", statement.Sid)
+					}
+					continue
+				}
+
+				sids[statement.Sid] = statement
+				merged.Statements = append(merged.Statements, statement)
+				continue
+			}
+
+			for _, existing := range merged.Statements {
+				if existing.Sid != "" {
+					continue
+				}
+
+				if verify.PolicyStringsEquivalent(existing.String(), statement.String()) {
+					continue statements
+				}
+
+				if policyStatementsDifferOnlyIn(existing, statement, func(s *policyStatement) *policyStringSet { return &s.Action }) {
+					existing.Action = append(existing.Action, statement.Action...).normalize()
+					continue statements
+				}
+
+				if policyStatementsDifferOnlyIn(existing, statement, func(s *policyStatement) *policyStringSet { return &s.Resource }) {
+					existing.Resource = append(existing.Resource, statement.Resource...).normalize()
+					continue statements
+				}
+			}
+
+			merged.Statements = append(merged.Statements, statement)
+		}
+	}
+
+	if merged.Version == "" {
+		merged.Version = policyVersion
+	}
+
+	if merged.Statements == nil {
+		merged.Statements = policyStatements{}
+	}
+
+	return merged, nil
+}
+
+// policyStatementsDifferOnlyIn returns whether two normalized statements are
+// identical apart from the (non-empty) element selected by field.
+func policyStatementsDifferOnlyIn(s1, s2 *policyStatement, field func(*policyStatement) *policyStringSet) bool {
+	if len(*field(s1)) == 0 || len(*field(s2)) == 0 {
+		return false
+	}
+
+	c1, c2 := *s1, *s2
+	*field(&c1), *field(&c2) = nil, nil
+
+	b1, err := json.Marshal(&c1)
+	if err != nil {
+		return false
+	}
+	b2, err := json.Marshal(&c2)
+	if err != nil {
+		return false
+	}
+
+	return slices.Equal(b1, b2)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/types"
 	"html/template"
 	"log"
 	"os"
@@ -35,6 +36,7 @@ var (
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
 	v2Suffix        = flag.Bool("V2Suffix", false, "whether to append a V2 suffix to the list functions")
+	items           = flag.String("Items", "", "comma-separated list of <function-name>:<items-field> pairs to generate item iterators for")
 )
 
 func usage() {
@@ -76,13 +78,36 @@ func main() {
 
 	awsService := service.GoV2Package()
 
-	functions := strings.Split(*listOps, ",")
-	slices.Sort(functions)
+	var functions []string
+	if *listOps != "" {
+		functions = strings.Split(*listOps, ",")
+		slices.Sort(functions)
+	}
+
+	var itemSpecs []itemSpec
+	if *items != "" {
+		for v := range strings.SplitSeq(*items, ",") {
+			functionName, field, ok := strings.Cut(v, ":")
+			if !ok || functionName == "" || field == "" {
+				log.Fatalf("invalid Items value %q, expected <function-name>:<items-field>", v)
+			}
+			itemSpecs = append(itemSpecs, itemSpec{functionName: functionName, field: field})
+		}
+		slices.SortFunc(itemSpecs, func(a, b itemSpec) int {
+			return strings.Compare(a.functionName, b.functionName)
+		})
+	}
+
+	if len(functions) == 0 && len(itemSpecs) == 0 {
+		log.Fatal("at least one of ListOps or Items must be specified")
+	}
 
 	tmpl := template.Must(template.New("function").Parse(functionTemplate))
+	itemsTmpl := template.Must(template.New("items").Parse(itemsTemplate))
 
 	g := Generator{
 		tmpl:            tmpl,
+		itemsTmpl:       itemsTmpl,
 		inputPaginator:  *inputPaginator,
 		outputPaginator: *outputPaginator,
 	}
@@ -91,16 +116,30 @@ func main() {
 
 	g.parsePackage(sourcePackage)
 
+	var itemFuncSpecs []ItemsFuncSpec
+	for _, spec := range itemSpecs {
+		itemFuncSpecs = append(itemFuncSpecs, g.itemsFuncSpec(spec, awsService, slices.Contains(functions, spec.functionName), *export))
+	}
+
 	g.printHeader(HeaderInfo{
 		Parameters:         strings.Join(os.Args[1:], " "),
 		DestinationPackage: servicePackage,
 		SourcePackage:      sourcePackage,
+		HasPages:           len(functions) > 0,
+		HasItems:           len(itemFuncSpecs) > 0,
+		HasAWSTypes:        slices.ContainsFunc(itemFuncSpecs, func(v ItemsFuncSpec) bool { return v.UsesAWSTypes }),
 	})
 
 	for _, functionName := range functions {
 		g.generateFunction(functionName, awsService, *export)
 	}
 
+	for _, spec := range itemFuncSpecs {
+		if err := g.itemsTmpl.Execute(&g.buf, spec); err != nil {
+			log.Fatalf("error writing items function \"%s\": %s", spec.Name, err)
+		}
+	}
+
 	src := g.format()
 
 	err = os.WriteFile(filename, src, 0644)
@@ -113,12 +152,16 @@ type HeaderInfo struct {
 	Parameters         string
 	DestinationPackage string
 	SourcePackage      string
+	HasPages           bool
+	HasItems           bool
+	HasAWSTypes        bool
 }
 
 type Generator struct {
 	buf             bytes.Buffer
 	pkg             *Package
 	tmpl            *template.Template
+	itemsTmpl       *template.Template
 	inputPaginator  string
 	outputPaginator string
 }
@@ -133,7 +176,9 @@ type PackageFile struct {
 
 type Package struct {
 	name  string
+	path  string
 	files []*PackageFile
+	types *types.Package
 }
 
 func (g *Generator) printHeader(headerInfo HeaderInfo) {
@@ -147,7 +192,7 @@ func (g *Generator) printHeader(headerInfo HeaderInfo) {
 
 func (g *Generator) parsePackage(sourcePackage string) {
 	cfg := &packages.Config{
-		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax,
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax,
 	}
 	pkgs, err := packages.Load(cfg, sourcePackage)
 	if err != nil {
@@ -162,7 +207,9 @@ func (g *Generator) parsePackage(sourcePackage string) {
 func (g *Generator) addPackage(pkg *packages.Package) {
 	g.pkg = &Package{
 		name:  pkg.Name,
+		path:  pkg.PkgPath,
 		files: make([]*PackageFile, len(pkg.Syntax)),
+		types: pkg.Types,
 	}
 
 	for i, file := range pkg.Syntax {
@@ -251,12 +298,116 @@ func (g *Generator) expandTypeExpr(expr ast.Expr) string {
 	return ""
 }
 
+type itemSpec struct {
+	functionName string
+	field        string
+}
+
+type ItemsFuncSpec struct {
+	Name         string
+	AWSName      string
+	AWSService   string
+	ParamType    string
+	ResultType   string
+	ItemType     string
+	ItemsField   string
+	NilChecks    []string
+	PagesName    string
+	UsePages     bool
+	UsesAWSTypes bool
+}
+
+// itemsFuncSpec returns the specification of an iterator over the items returned by an AWS SDK function.
+// Items are read from the SDK's paginator or, if the function is also being wrapped, from the generated Pages function.
+func (g *Generator) itemsFuncSpec(spec itemSpec, awsService string, hasPages, export bool) ItemsFuncSpec {
+	scope := g.pkg.types.Scope()
+
+	if scope.Lookup(spec.functionName+"Input") == nil {
+		log.Fatalf("function \"%s\" not found", spec.functionName)
+	}
+
+	if !hasPages && scope.Lookup("New"+spec.functionName+"Paginator") == nil {
+		log.Fatalf("function \"%s\" has no paginator, add it to ListOps", spec.functionName)
+	}
+
+	output := scope.Lookup(spec.functionName + "Output")
+	if output == nil {
+		log.Fatalf("function \"%s\" has no output type", spec.functionName)
+	}
+
+	var (
+		typ       = output.Type()
+		path      []string
+		nilChecks []string
+	)
+	for i, fieldName := range strings.Split(spec.field, ".") {
+		if i > 0 {
+			ptr, ok := typ.(*types.Pointer)
+			if !ok {
+				log.Fatalf("function \"%s\": field \"%s\" is not a pointer to a structure", spec.functionName, strings.Join(path, "."))
+			}
+			nilChecks = append(nilChecks, strings.Join(path, "."))
+			typ = ptr.Elem()
+		}
+
+		obj, _, _ := types.LookupFieldOrMethod(typ, false, g.pkg.types, fieldName)
+		field, ok := obj.(*types.Var)
+		if !ok || !field.IsField() {
+			log.Fatalf("function \"%s\": field \"%s\" not found", spec.functionName, fieldName)
+		}
+
+		path = append(path, fieldName)
+		typ = field.Type()
+	}
+
+	slice, ok := typ.(*types.Slice)
+	if !ok {
+		log.Fatalf("function \"%s\": field \"%s\" is not a slice", spec.functionName, spec.field)
+	}
+
+	var usesAWSTypes bool
+	itemType := types.TypeString(slice.Elem(), func(pkg *types.Package) string {
+		switch pkg.Path() {
+		case g.pkg.path:
+			return g.pkg.name
+		case g.pkg.path + "/types":
+			usesAWSTypes = true
+			return "awstypes"
+		default:
+			log.Fatalf("function \"%s\": unsupported item type package \"%s\"", spec.functionName, pkg.Path())
+			return ""
+		}
+	})
+
+	funcName := spec.functionName
+	if !export {
+		funcName = fmt.Sprintf("%s%s", strings.ToLower(funcName[0:1]), funcName[1:])
+	}
+
+	return ItemsFuncSpec{
+		Name:         fmt.Sprintf("%sItems", funcName),
+		AWSName:      spec.functionName,
+		AWSService:   awsService,
+		ParamType:    fmt.Sprintf("*%s.%sInput", g.pkg.name, spec.functionName),
+		ResultType:   fmt.Sprintf("*%s.%sOutput", g.pkg.name, spec.functionName),
+		ItemType:     itemType,
+		ItemsField:   spec.field,
+		NilChecks:    nilChecks,
+		PagesName:    fmt.Sprintf("%sPages", fixSomeInitialisms(funcName)),
+		UsePages:     hasPages,
+		UsesAWSTypes: usesAWSTypes,
+	}
+}
+
 //go:embed v2/header.gtpl
 var headerTemplate string
 
 //go:embed v2/function.gtpl
 var functionTemplate string
 
+//go:embed v2/items.gtpl
+var itemsTemplate string
+
 func (g *Generator) format() []byte {
 	src, err := format.Source(g.buf.Bytes())
 	if err != nil {
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed actions.tmpl
+var tmpl string
+
+type Service struct {
+	Prefix  string   `hcl:"prefix,label"`
+	Actions []string `hcl:"actions"`
+}
+
+type Config struct {
+	Services []Service `hcl:"service,block"`
+}
+
+func main() {
+	const (
+		source   = `../../../internal/verify/policylint/actions.hcl`
+		filename = `../../../internal/verify/policylint/actions_gen.go`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", strings.TrimPrefix(filename, "../../../"))
+
+	var config Config
+	err := hclsimple.DecodeFile(source, nil, &config)
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	// Sort by service prefix, then action name
+	slices.SortFunc(config.Services, func(a, b Service) int {
+		return strings.Compare(a.Prefix, b.Prefix)
+	})
+	for _, service := range config.Services {
+		slices.Sort(service.Actions)
+	}
+
+	data := map[string]any{
+		"Services": config.Services,
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("actions", tmpl, data); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
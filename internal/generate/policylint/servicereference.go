@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed servicereference.tmpl
+var serviceReferenceTmpl string
+
+// serviceReferenceURL lists every service in the AWS Service Authorization Reference
+// and the URL of each service's actions, resources and condition keys.
+const serviceReferenceURL = `https://servicereference.us-east-1.amazonaws.com/`
+
+type serviceReferenceEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+}
+
+type Service struct {
+	Prefix  string
+	Actions []string
+}
+
+func main() {
+	const (
+		filename = `../../../internal/verify/policylint/actions.hcl`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", strings.TrimPrefix(filename, "../../../"))
+
+	ctx := context.Background()
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	var entries []serviceReferenceEntry
+	if err := getJSON(ctx, client, serviceReferenceURL, &entries); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	services := make([]Service, 0, len(entries))
+	for _, entry := range entries {
+		var ref serviceReference
+		if err := getJSON(ctx, client, entry.URL, &ref); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		service := Service{
+			Prefix: entry.Service,
+		}
+		for _, action := range ref.Actions {
+			service.Actions = append(service.Actions, action.Name)
+		}
+
+		// Sort by action name
+		slices.Sort(service.Actions)
+		service.Actions = slices.Compact(service.Actions)
+
+		services = append(services, service)
+	}
+
+	// Sort by service prefix
+	slices.SortFunc(services, func(a, b Service) int {
+		return strings.Compare(a.Prefix, b.Prefix)
+	})
+
+	data := map[string]any{
+		"Services": services,
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferTemplate("servicereference", serviceReferenceTmpl, data); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("reading %s: %s", url, resp.Status)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s: %w", url, err)
+	}
+
+	return nil
+}
@@ -92,6 +92,10 @@ func main() {
 
 		for key, value := range v.frameworkListResources {
 			if val, exists := v.frameworkResources[key]; exists {
+				if !val.ResourceIdentity.HasResourceIdentity() {
+					g.Fatalf("Framework List Resource %q: matching Framework Resource has no Resource Identity", key)
+				}
+
 				value.Name = val.Name
 				value.ResourceIdentity = val.ResourceIdentity
 				value.TransparentTagging = val.TransparentTagging
@@ -106,6 +110,10 @@ func main() {
 
 		for key, value := range v.sdkListResources {
 			if val, exists := v.sdkResources[key]; exists {
+				if !val.ResourceIdentity.HasResourceIdentity() {
+					g.Fatalf("SDK List Resource %q: matching SDK Resource has no Resource Identity", key)
+				}
+
 				value.Name = val.Name
 				value.ResourceIdentity = val.ResourceIdentity
 				value.TransparentTagging = val.TransparentTagging
@@ -30,6 +30,7 @@ type CommonArgs struct {
 
 	// CheckExists
 	HasExistsFunc  bool
+	existsFuncName string
 	ExistsTypeName string
 	existsTakesNoT bool
 
@@ -154,6 +155,13 @@ func (c CommonArgs) DestroyTakesT() bool {
 	return !c.destroyTakesNoT
 }
 
+func (c CommonArgs) ExistsFunc() string {
+	if c.existsFuncName == "" {
+		return "testAccCheck" + c.Name + "Exists"
+	}
+	return c.existsFuncName
+}
+
 func (c CommonArgs) ExistsTakesT() bool {
 	return !c.existsTakesNoT
 }
@@ -235,6 +243,10 @@ func ParseTestingAnnotations(args common.Args, stuff *CommonArgs) error {
 		}
 	}
 
+	if attr, ok := args.Keyword["existsFunc"]; ok {
+		stuff.existsFuncName = attr
+	}
+
 	if attr, ok := args.Keyword["existsType"]; ok {
 		if typeName, importSpec, err := common.ParseIdentifierSpec(attr); err != nil {
 			return fmt.Errorf("%s: %w", attr, err)
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// providerServer is the subset of the provider's protocol version 5 server used to generate import blocks.
+type providerServer interface {
+	GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error)
+	GetResourceIdentitySchemas(context.Context, *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error)
+	PrepareProviderConfig(context.Context, *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error)
+	ConfigureProvider(context.Context, *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error)
+	ListResource(context.Context, *tfprotov5.ListResourceRequest) (*tfprotov5.ListResourceServerStream, error)
+}
+
+// generator lists existing resources using the provider's list resources and
+// describes each resource by its resource identity and current state.
+type generator struct {
+	server          providerServer
+	providerSchema  *tfprotov5.Schema
+	resourceSchemas map[string]*tfprotov5.Schema
+	listSchemas     map[string]*tfprotov5.Schema
+	identitySchemas map[string]*tfprotov5.ResourceIdentitySchema
+}
+
+// importable is an existing resource that can be imported.
+type importable struct {
+	TypeName    string
+	DisplayName string
+	Identity    tftypes.Value
+	State       tftypes.Value
+}
+
+func newGenerator(ctx context.Context, server providerServer) (*generator, error) {
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("getting provider schema: %w", err)
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		return nil, fmt.Errorf("getting provider schema: %w", err)
+	}
+
+	identitySchemas, err := server.GetResourceIdentitySchemas(ctx, &tfprotov5.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("getting resource identity schemas: %w", err)
+	}
+	if err := diagnosticsError(identitySchemas.Diagnostics); err != nil {
+		return nil, fmt.Errorf("getting resource identity schemas: %w", err)
+	}
+
+	return &generator{
+		server:          server,
+		providerSchema:  schemas.Provider,
+		resourceSchemas: schemas.ResourceSchemas,
+		listSchemas:     schemas.ListResourceSchemas,
+		identitySchemas: identitySchemas.IdentitySchemas,
+	}, nil
+}
+
+// typeNames returns the resource types that can be listed and imported by identity.
+func (g *generator) typeNames() []string {
+	var typeNames []string
+
+	for typeName := range g.listSchemas {
+		if _, ok := g.resourceSchemas[typeName]; !ok {
+			continue
+		}
+		if _, ok := g.identitySchemas[typeName]; !ok {
+			continue
+		}
+
+		typeNames = append(typeNames, typeName)
+	}
+
+	slices.Sort(typeNames)
+
+	return typeNames
+}
+
+// isRegional returns whether the list resource for the specified resource type supports the "region" argument.
+func (g *generator) isRegional(typeName string) bool {
+	return slices.ContainsFunc(g.listSchemas[typeName].Block.Attributes, func(v *tfprotov5.SchemaAttribute) bool {
+		return v.Name == names.AttrRegion
+	})
+}
+
+// configure configures the provider with the specified provider configuration arguments.
+func (g *generator) configure(ctx context.Context, args map[string]tftypes.Value) error {
+	config, err := newObjectValue(g.providerSchema.Block, args)
+	if err != nil {
+		return fmt.Errorf("building provider configuration: %w", err)
+	}
+
+	dv, err := tfprotov5.NewDynamicValue(g.providerSchema.ValueType(), config)
+	if err != nil {
+		return fmt.Errorf("building provider configuration: %w", err)
+	}
+
+	prepared, err := g.server.PrepareProviderConfig(ctx, &tfprotov5.PrepareProviderConfigRequest{
+		Config: &dv,
+	})
+	if err != nil {
+		return fmt.Errorf("preparing provider configuration: %w", err)
+	}
+	if err := diagnosticsError(prepared.Diagnostics); err != nil {
+		return fmt.Errorf("preparing provider configuration: %w", err)
+	}
+	if prepared.PreparedConfig != nil {
+		dv = *prepared.PreparedConfig
+	}
+
+	configured, err := g.server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: &dv,
+	})
+	if err != nil {
+		return fmt.Errorf("configuring provider: %w", err)
+	}
+	if err := diagnosticsError(configured.Diagnostics); err != nil {
+		return fmt.Errorf("configuring provider: %w", err)
+	}
+
+	return nil
+}
+
+// list returns the existing resources of the specified type in the specified Region.
+// If region is empty the provider's configured Region is used.
+// Errors reading individual resources are reported via warn and the resources are skipped.
+func (g *generator) list(ctx context.Context, typeName, region string, warn func(string)) ([]importable, error) {
+	listSchema, ok := g.listSchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("%s has no list resource", typeName)
+	}
+	resourceSchema, ok := g.resourceSchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("%s has no resource schema", typeName)
+	}
+	identitySchema, ok := g.identitySchemas[typeName]
+	if !ok {
+		return nil, fmt.Errorf("%s has no resource identity schema", typeName)
+	}
+
+	args := make(map[string]tftypes.Value)
+	if region != "" {
+		args[names.AttrRegion] = tftypes.NewValue(tftypes.String, region)
+	}
+	config, err := newObjectValue(listSchema.Block, args)
+	if err != nil {
+		return nil, fmt.Errorf("building %s list configuration: %w", typeName, err)
+	}
+
+	dv, err := tfprotov5.NewDynamicValue(listSchema.ValueType(), config)
+	if err != nil {
+		return nil, fmt.Errorf("building %s list configuration: %w", typeName, err)
+	}
+
+	stream, err := g.server.ListResource(ctx, &tfprotov5.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          &dv,
+		IncludeResource: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", typeName, err)
+	}
+
+	var importables []importable
+
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			if result.Identity == nil {
+				// Diagnostics not associated with a resource terminate the listing.
+				return nil, fmt.Errorf("listing %s: %w", typeName, err)
+			}
+
+			warn(fmt.Sprintf("skipping %s %q: %s", typeName, result.DisplayName, err))
+			continue
+		}
+
+		if result.Identity == nil || result.Identity.IdentityData == nil || result.Resource == nil {
+			warn(fmt.Sprintf("skipping %s %q: no resource identity or state returned", typeName, result.DisplayName))
+			continue
+		}
+
+		identity, err := result.Identity.IdentityData.Unmarshal(identitySchema.ValueType())
+		if err != nil {
+			return nil, fmt.Errorf("reading %s %q resource identity: %w", typeName, result.DisplayName, err)
+		}
+
+		state, err := result.Resource.Unmarshal(resourceSchema.ValueType())
+		if err != nil {
+			return nil, fmt.Errorf("reading %s %q state: %w", typeName, result.DisplayName, err)
+		}
+
+		importables = append(importables, importable{
+			TypeName:    typeName,
+			DisplayName: result.DisplayName,
+			Identity:    identity,
+			State:       state,
+		})
+	}
+
+	return importables, nil
+}
+
+// newObjectValue returns a value for the specified schema block with the specified top-level attribute values.
+// All other attributes are null and all other nested blocks are empty.
+func newObjectValue(block *tfprotov5.SchemaBlock, args map[string]tftypes.Value) (tftypes.Value, error) {
+	args = maps.Clone(args)
+	values := make(map[string]tftypes.Value)
+
+	for _, attribute := range block.Attributes {
+		if v, ok := args[attribute.Name]; ok {
+			if !v.Type().Equal(attribute.ValueType()) {
+				return tftypes.Value{}, fmt.Errorf("argument %q: expected %s, got %s", attribute.Name, attribute.ValueType(), v.Type())
+			}
+			values[attribute.Name] = v
+			delete(args, attribute.Name)
+			continue
+		}
+
+		values[attribute.Name] = tftypes.NewValue(attribute.ValueType(), nil)
+	}
+
+	for _, nestedBlock := range block.BlockTypes {
+		typ := nestedBlock.ValueType()
+
+		switch nestedBlock.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			values[nestedBlock.TypeName] = tftypes.NewValue(typ, []tftypes.Value{})
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			values[nestedBlock.TypeName] = tftypes.NewValue(typ, map[string]tftypes.Value{})
+		default:
+			values[nestedBlock.TypeName] = tftypes.NewValue(typ, nil)
+		}
+	}
+
+	if len(args) > 0 {
+		return tftypes.Value{}, fmt.Errorf("unsupported arguments: %s", strings.Join(slices.Sorted(maps.Keys(args)), ", "))
+	}
+
+	return tftypes.NewValue(block.ValueType(), values), nil
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []error
+
+	for _, diag := range diags {
+		if diag == nil || diag.Severity != tfprotov5.DiagnosticSeverityError {
+			continue
+		}
+
+		if diag.Detail != "" {
+			errs = append(errs, fmt.Errorf("%s: %s", diag.Summary, diag.Detail))
+		} else {
+			errs = append(errs, errors.New(diag.Summary))
+		}
+	}
+
+	return errors.Join(errs...)
+}
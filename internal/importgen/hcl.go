@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// skippedAttributes are resource attributes that are never written to resource blocks.
+var skippedAttributes = []string{
+	names.AttrID,
+	names.AttrTagsAll,
+}
+
+// writer writes import blocks and skeleton resource blocks for existing resources.
+type writer struct {
+	file *hclwrite.File
+	// region is the provider's configured Region. Resource "region" arguments with this value are omitted.
+	region string
+	// resourceNames tracks the resource names used for each resource type.
+	resourceNames map[string]map[string]bool
+}
+
+func newWriter(region string) *writer {
+	return &writer{
+		file:          hclwrite.NewEmptyFile(),
+		region:        region,
+		resourceNames: make(map[string]map[string]bool),
+	}
+}
+
+// Bytes returns the formatted configuration.
+func (w *writer) Bytes() []byte {
+	return hclwrite.Format(w.file.Bytes())
+}
+
+// write appends an import block and a resource block for the specified resource.
+func (w *writer) write(v importable, schema *tfprotov5.Schema) error {
+	name := w.resourceName(v)
+
+	attributes, err := objectAttributes(v.Identity)
+	if err != nil {
+		return fmt.Errorf("reading %s %q resource identity: %w", v.TypeName, v.DisplayName, err)
+	}
+
+	body := w.file.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	if v.DisplayName != "" {
+		body.AppendUnstructuredTokens(hclwrite.Tokens{
+			{Type: hclsyntax.TokenComment, Bytes: []byte("# " + v.DisplayName + "\n")},
+		})
+	}
+
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: v.TypeName},
+		hcl.TraverseAttr{Name: name},
+	})
+
+	var identity []hclwrite.ObjectAttrTokens
+	for _, k := range slices.Sorted(maps.Keys(attributes)) {
+		value, err := toCty(attributes[k])
+		if err != nil {
+			return fmt.Errorf("reading %s %q resource identity: %w", v.TypeName, v.DisplayName, err)
+		}
+		if value.IsNull() {
+			continue
+		}
+
+		identity = append(identity, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(k),
+			Value: hclwrite.TokensForValue(value),
+		})
+	}
+	block.SetAttributeRaw("identity", hclwrite.TokensForObject(identity))
+
+	body.AppendNewline()
+
+	block = body.AppendNewBlock("resource", []string{v.TypeName, name}).Body()
+	if err := w.writeBlock(block, schema.Block, v.State, true); err != nil {
+		return fmt.Errorf("writing %s %q: %w", v.TypeName, v.DisplayName, err)
+	}
+
+	return nil
+}
+
+// writeBlock writes the arguments of a resource or nested block.
+// Required arguments are always written. Optional arguments are written if they are set in the resource's state.
+// Computed-only, deprecated and write-only attributes are never written.
+func (w *writer) writeBlock(body *hclwrite.Body, block *tfprotov5.SchemaBlock, state tftypes.Value, topLevel bool) error {
+	values, err := objectAttributes(state)
+	if err != nil {
+		return err
+	}
+
+	attributes := slices.Clone(block.Attributes)
+	slices.SortFunc(attributes, func(a, b *tfprotov5.SchemaAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, attribute := range attributes {
+		if !attribute.Required && !attribute.Optional {
+			continue
+		}
+		if attribute.Deprecated || attribute.WriteOnly {
+			continue
+		}
+		if topLevel && slices.Contains(skippedAttributes, attribute.Name) {
+			continue
+		}
+
+		value, err := toCty(values[attribute.Name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", attribute.Name, err)
+		}
+
+		switch {
+		case attribute.Required && (value.IsNull() || attribute.Sensitive):
+			// The value must be supplied by the user.
+			body.SetAttributeRaw(attribute.Name, hclwrite.Tokens{
+				{Type: hclsyntax.TokenIdent, Bytes: []byte("null")},
+				{Type: hclsyntax.TokenComment, Bytes: []byte(" # TODO: required")},
+			})
+			continue
+		case attribute.Sensitive, isEmpty(value):
+			continue
+		}
+
+		if topLevel {
+			switch attribute.Name {
+			case names.AttrRegion:
+				if value.AsString() == w.region {
+					continue
+				}
+			case names.AttrNamePrefix:
+				// "name_prefix" is only used to generate a "name" when the resource is created.
+				if v, err := toCty(values[names.AttrName]); err == nil && !isEmpty(v) {
+					continue
+				}
+			}
+		}
+
+		body.SetAttributeValue(attribute.Name, value)
+	}
+
+	blockTypes := slices.Clone(block.BlockTypes)
+	slices.SortFunc(blockTypes, func(a, b *tfprotov5.SchemaNestedBlock) int {
+		return strings.Compare(a.TypeName, b.TypeName)
+	})
+
+	for _, blockType := range blockTypes {
+		if blockType.Block.Deprecated {
+			continue
+		}
+
+		var elements []tftypes.Value
+
+		value := values[blockType.TypeName]
+		if value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		switch blockType.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeSingle, tfprotov5.SchemaNestedBlockNestingModeGroup:
+			elements = []tftypes.Value{value}
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			if err := value.As(&elements); err != nil {
+				return fmt.Errorf("%s: %w", blockType.TypeName, err)
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeMap:
+			var m map[string]tftypes.Value
+			if err := value.As(&m); err != nil {
+				return fmt.Errorf("%s: %w", blockType.TypeName, err)
+			}
+			for _, k := range slices.Sorted(maps.Keys(m)) {
+				if err := w.writeBlock(body.AppendNewBlock(blockType.TypeName, []string{k}).Body(), blockType.Block, m[k], false); err != nil {
+					return fmt.Errorf("%s: %w", blockType.TypeName, err)
+				}
+			}
+			continue
+		}
+
+		for _, element := range elements {
+			if err := w.writeBlock(body.AppendNewBlock(blockType.TypeName, nil).Body(), blockType.Block, element, false); err != nil {
+				return fmt.Errorf("%s: %w", blockType.TypeName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceName returns a unique resource name for the specified resource.
+// The name is derived from the resource's display name, falling back to its identity.
+func (w *writer) resourceName(v importable) string {
+	name := v.DisplayName
+	if name == "" {
+		if attributes, err := objectAttributes(v.Identity); err == nil {
+			for _, k := range slices.Sorted(maps.Keys(attributes)) {
+				if k == names.AttrAccountID || k == names.AttrRegion {
+					continue
+				}
+				var s string
+				if attributes[k].Type().Is(tftypes.String) && attributes[k].As(&s) == nil && s != "" {
+					name = s
+					break
+				}
+			}
+		}
+	}
+
+	name = sanitizeResourceName(name)
+
+	if w.resourceNames[v.TypeName] == nil {
+		w.resourceNames[v.TypeName] = make(map[string]bool)
+	}
+	used := w.resourceNames[v.TypeName]
+
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	used[unique] = true
+
+	return unique
+}
+
+// sanitizeResourceName returns a valid resource name derived from s.
+func sanitizeResourceName(s string) string {
+	var sb strings.Builder
+
+	underscore := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+			underscore = false
+			continue
+		}
+
+		if !underscore && sb.Len() > 0 {
+			sb.WriteRune('_')
+			underscore = true
+		}
+	}
+
+	name := strings.TrimSuffix(sb.String(), "_")
+	switch {
+	case name == "":
+		name = "this"
+	case name[0] >= '0' && name[0] <= '9':
+		name = "r_" + name
+	}
+
+	return name
+}
+
+func objectAttributes(v tftypes.Value) (map[string]tftypes.Value, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func isEmpty(v cty.Value) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return true
+	}
+
+	t := v.Type()
+	switch {
+	case t == cty.String:
+		return v.AsString() == ""
+	case t.IsListType(), t.IsSetType(), t.IsMapType(), t.IsTupleType():
+		return v.LengthInt() == 0
+	}
+
+	return false
+}
+
+// toCty converts a value to its HCL representation.
+// Collections are converted to tuples and objects, and null object attributes are removed, so that
+// the generated configuration only contains set values.
+func toCty(v tftypes.Value) (cty.Value, error) {
+	if !v.IsKnown() {
+		return cty.DynamicVal, nil
+	}
+	if v.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.StringVal(s), nil
+
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.NumberVal(&n), nil
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return cty.NilVal, err
+		}
+		return cty.BoolVal(b), nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			value, err := toCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		return cty.TupleVal(values), nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := v.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+
+		values := make(map[string]cty.Value, len(elements))
+		for k, element := range elements {
+			value, err := toCty(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			if typ.Is(tftypes.Object{}) && value.IsNull() {
+				continue
+			}
+			values[k] = value
+		}
+		if len(values) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		return cty.ObjectVal(values), nil
+	}
+
+	return cty.NilVal, fmt.Errorf("unsupported type: %s", typ)
+}
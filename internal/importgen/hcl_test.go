@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSanitizeResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":                                     "this",
+		"example":                              "example",
+		"My-Bucket.Name":                       "my_bucket_name",
+		"  leading/trailing ":                  "leading_trailing",
+		"123abc":                               "r_123abc",
+		"arn:aws:iam::123456789012:role/Admin": "arn_aws_iam_123456789012_role_admin",
+	}
+
+	for input, expected := range testCases {
+		if got := sanitizeResourceName(input); got != expected {
+			t.Errorf("sanitizeResourceName(%q) = %q, want %q", input, got, expected)
+		}
+	}
+}
+
+func TestWriterWrite(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "arn", Type: tftypes.String, Computed: true},
+				{Name: "id", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "name", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "name_prefix", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "password", Type: tftypes.String, Required: true, Sensitive: true},
+				{Name: "port", Type: tftypes.Number, Optional: true},
+				{Name: "region", Type: tftypes.String, Optional: true, Computed: true},
+				{Name: "tags", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true},
+				{Name: "tags_all", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true, Computed: true},
+				{Name: "legacy", Type: tftypes.String, Optional: true, Deprecated: true},
+				{Name: "description", Type: tftypes.String, Optional: true},
+			},
+			BlockTypes: []*tfprotov5.SchemaNestedBlock{
+				{
+					TypeName: "rule",
+					Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+					Block: &tfprotov5.SchemaBlock{
+						Attributes: []*tfprotov5.SchemaAttribute{
+							{Name: "action", Type: tftypes.String, Required: true},
+							{Name: "id", Type: tftypes.String, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+	stateType := schema.ValueType()
+	ruleType := schema.Block.BlockTypes[0].Block.ValueType()
+
+	identityType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"account_id": tftypes.String,
+			"name":       tftypes.String,
+			"region":     tftypes.String,
+		},
+	}
+
+	newImportable := func(name, region string) importable {
+		return importable{
+			TypeName:    "aws_example",
+			DisplayName: name,
+			Identity: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"account_id": tftypes.NewValue(tftypes.String, "123456789012"),
+				"name":       tftypes.NewValue(tftypes.String, name),
+				"region":     tftypes.NewValue(tftypes.String, region),
+			}),
+			State: tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"arn":         tftypes.NewValue(tftypes.String, "arn:aws:example:"+region+":123456789012:"+name),
+				"id":          tftypes.NewValue(tftypes.String, name),
+				"name":        tftypes.NewValue(tftypes.String, name),
+				"name_prefix": tftypes.NewValue(tftypes.String, "ex"),
+				"password":    tftypes.NewValue(tftypes.String, nil),
+				"port":        tftypes.NewValue(tftypes.Number, 8080),
+				"region":      tftypes.NewValue(tftypes.String, region),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Name": tftypes.NewValue(tftypes.String, name),
+				}),
+				"tags_all": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"Name": tftypes.NewValue(tftypes.String, name),
+				}),
+				"legacy":      tftypes.NewValue(tftypes.String, "old"),
+				"description": tftypes.NewValue(tftypes.String, ""),
+				"rule": tftypes.NewValue(tftypes.List{ElementType: ruleType}, []tftypes.Value{
+					tftypes.NewValue(ruleType, map[string]tftypes.Value{
+						"action": tftypes.NewValue(tftypes.String, "allow"),
+						"id":     tftypes.NewValue(tftypes.String, nil),
+					}),
+				}),
+			}),
+		}
+	}
+
+	w := newWriter("us-west-2") //lintignore:AWSAT003
+
+	for _, v := range []importable{
+		newImportable("example", "us-west-2"), //lintignore:AWSAT003
+		newImportable("example", "us-east-1"), //lintignore:AWSAT003
+	} {
+		if err := w.write(v, schema); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	//lintignore:AWSAT003,AWSAT005
+	expected := `# example
+import {
+  to = aws_example.example
+  identity = {
+    account_id = "123456789012"
+    name       = "example"
+    region     = "us-west-2"
+  }
+}
+
+resource "aws_example" "example" {
+  name     = "example"
+  password = null # TODO: required
+  port     = 8080
+  tags = {
+    Name = "example"
+  }
+  rule {
+    action = "allow"
+  }
+}
+
+# example
+import {
+  to = aws_example.example_2
+  identity = {
+    account_id = "123456789012"
+    name       = "example"
+    region     = "us-east-1"
+  }
+}
+
+resource "aws_example" "example_2" {
+  name     = "example"
+  password = null # TODO: required
+  port     = 8080
+  region   = "us-east-1"
+  tags = {
+    Name = "example"
+  }
+  rule {
+    action = "allow"
+  }
+}
+`
+
+	if diff := cmp.Diff(expected, string(w.Bytes())); diff != "" {
+		t.Errorf("unexpected configuration (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// importgen writes Terraform configuration for importing existing AWS resources.
+//
+// Usage:
+//
+//	go run ./internal/importgen [flags]
+//
+// Existing resources are found using the provider's list resources. For each resource an
+// `import` block that identifies the resource by its resource identity and a skeleton
+// `resource` block are written. Resource blocks contain the resource's required arguments
+// and any optional arguments that are set, taken from the resource's current state.
+// Required arguments that cannot be read, such as passwords, are written as null and must
+// be completed before the configuration is applied.
+//
+// Credentials are loaded in the same way as the provider, for example from the standard
+// AWS environment variables or the profile specified by -profile.
+package main
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func main() {
+	log.SetFlags(0)
+
+	accountID := flag.String("account-id", "", "AWS account ID that credentials must belong to")
+	output := flag.String("o", "", "file to write configuration to (default standard output)")
+	profile := flag.String("profile", "", "AWS shared configuration profile")
+	region := flag.String("region", "", "Region to configure the provider with")
+	regions := flag.String("regions", "", "comma-separated list of Regions to list regional resources in (default the provider's Region)")
+	types := flag.String("types", "", "comma-separated list of resource types to import (default all resource types with list resources)")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		log.Fatalf("creating provider: %s", err)
+	}
+
+	server, ok := factory().(providerServer)
+	if !ok {
+		log.Fatal("provider does not support list resources")
+	}
+
+	g, err := newGenerator(ctx, server)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	typeNames := g.typeNames()
+	if *types != "" {
+		requested := splitList(*types)
+		for _, typeName := range requested {
+			if !slices.Contains(typeNames, typeName) {
+				log.Fatalf("%s does not support importing by resource identity from a list resource", typeName)
+			}
+		}
+		typeNames = requested
+	}
+
+	args := make(map[string]tftypes.Value)
+	if *accountID != "" {
+		args["allowed_account_ids"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, *accountID),
+		})
+	}
+	if *profile != "" {
+		args[names.AttrProfile] = tftypes.NewValue(tftypes.String, *profile)
+	}
+	if *region != "" {
+		args[names.AttrRegion] = tftypes.NewValue(tftypes.String, *region)
+	}
+
+	if err := g.configure(ctx, args); err != nil {
+		log.Fatal(err)
+	}
+
+	// An empty Region lists resources in the provider's Region.
+	listRegions := []string{""}
+	if *regions != "" {
+		listRegions = splitList(*regions)
+	}
+
+	warn := func(s string) {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", s)
+	}
+
+	providerRegion := *region
+	if providerRegion == "" {
+		providerRegion = cmp.Or(os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"))
+	}
+
+	w := newWriter(providerRegion)
+	count := 0
+
+	for _, typeName := range typeNames {
+		regions := listRegions
+		if !g.isRegional(typeName) {
+			regions = []string{""}
+		}
+
+		schema := g.resourceSchemas[typeName]
+
+		for _, region := range regions {
+			importables, err := g.list(ctx, typeName, region, warn)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			for _, v := range importables {
+				if err := w.write(v, schema); err != nil {
+					log.Fatal(err)
+				}
+				count++
+			}
+		}
+	}
+
+	if *output == "" {
+		if _, err := os.Stdout.Write(w.Bytes()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := os.WriteFile(*output, w.Bytes(), 0644); err != nil {
+		log.Fatalf("writing %s: %s", *output, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Wrote %d import blocks to %s\n", count, *output)
+}
+
+func splitList(s string) []string {
+	var values []string
+
+	for v := range strings.SplitSeq(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 )
 
 func TestIdentityInterceptor(t *testing.T) {
@@ -398,6 +399,10 @@ func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) PolicyLintConfig(context.Context) *policylint.Config {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
@@ -406,6 +411,10 @@ func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) TagsReportingConfig(ctx context.Context) *tftags.ReportingConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	panic("not implemented") //lintignore:R009
 }
@@ -18,6 +18,7 @@ import (
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 )
 
 type awsClient interface {
@@ -26,8 +27,10 @@ type awsClient interface {
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
 	Partition(context.Context) string
+	PolicyLintConfig(context.Context) *policylint.Config
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
+	TagsReportingConfig(context.Context) *tftags.ReportingConfig
 	ValidateInContextRegionInPartition(ctx context.Context) error
 	AwsConfig(context.Context) aws.Config
 }
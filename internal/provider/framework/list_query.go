@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+)
+
+type listResourceInjectQueryArgumentsInterceptor struct {
+	tagged bool
+}
+
+func (r listResourceInjectQueryArgumentsInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		listresource.InjectQueryArguments(&response.Schema, r.tagged)
+	}
+}
+
+// listResourceInjectQueryArguments injects the standard "name_prefix" and "tag_filters" arguments into a resource's List schema.
+func listResourceInjectQueryArguments(tagged bool) listResourceSchemaInterceptor {
+	return &listResourceInjectQueryArgumentsInterceptor{
+		tagged: tagged,
+	}
+}
+
+// newListQuery returns the standard list query for a List request and the request to pass to the inner list resource.
+func newListQuery(ctx context.Context, inner list.ListResourceWithConfigure, request list.ListRequest) (*listresource.Query, list.ListRequest, diag.Diagnostics) {
+	var response list.ListResourceSchemaResponse
+	inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &response)
+	if response.Diagnostics.HasError() {
+		return nil, request, response.Diagnostics
+	}
+
+	query, request, diags := listresource.NewQuery(ctx, request, response.Schema)
+	response.Diagnostics.Append(diags...)
+
+	return query, request, response.Diagnostics
+}
+
+// listWithQuery runs a list resource's List handler for a list query.
+// If the list resource does not apply all the query's filters and the full resource state was not requested,
+// the handler is run again with the full resource state requested so that the provider can filter the results.
+// Filters are applied before results are streamed, so the discarded results of the first run make no API calls.
+func listWithQuery(ctx context.Context, query *listresource.Query, request list.ListRequest, stream *list.ListResultsStream, f func(context.Context, list.ListRequest, *list.ListResultsStream)) {
+	results := stream.Results
+
+	f(ctx, request, stream)
+
+	if !request.IncludeResource && query.HasUnappliedFilters() {
+		request.IncludeResource = true
+		stream.Results = results
+
+		f(ctx, request, stream)
+	}
+
+	stream.Results = query.Filter(ctx, stream.Results)
+}
+
+// readListResults replaces the state of each list result with the state returned by the resource's own Read.
+// Results for resources that Read reports as deleted are dropped.
+func readListResults(ctx context.Context, r resource.Resource, results iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	providerMeta := tfsdk.Config{
+		Raw:    tftypes.NewValue(providerMetaSchema().Type().TerraformType(ctx), nil),
+		Schema: providerMetaSchema(),
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		for result := range results {
+			if !result.Diagnostics.HasError() && result.Resource != nil && !result.Resource.Raw.IsNull() {
+				state := tfsdk.State{
+					Raw:    result.Resource.Raw,
+					Schema: result.Resource.Schema,
+				}
+				request := resource.ReadRequest{
+					State:        state,
+					Identity:     result.Identity,
+					ProviderMeta: providerMeta,
+				}
+				response := resource.ReadResponse{
+					State:    state,
+					Identity: result.Identity,
+				}
+
+				r.Read(ctx, request, &response)
+				result.Diagnostics.Append(response.Diagnostics...)
+
+				if !response.Diagnostics.HasError() {
+					if response.State.Raw.IsNull() {
+						continue
+					}
+
+					result.Resource.Raw = response.State.Raw
+					result.Identity = response.Identity
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+)
+
+func TestListWithQuery(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query           listresource.Query
+		includeResource bool
+		applyEagerly    bool
+		expectedCalls   []bool // IncludeResource of each call to the List handler
+	}{
+		"no filters": {
+			expectedCalls: []bool{false},
+		},
+		"name prefix applied": {
+			query:         listresource.Query{NamePrefix: "tf-acc-test"},
+			applyEagerly:  true,
+			expectedCalls: []bool{false},
+		},
+		"name prefix applied while streaming": {
+			query:         listresource.Query{NamePrefix: "tf-acc-test"},
+			expectedCalls: []bool{false, true},
+		},
+		"name prefix not applied with resource included": {
+			query:           listresource.Query{NamePrefix: "tf-acc-test"},
+			includeResource: true,
+			expectedCalls:   []bool{true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			query := testCase.query
+			ctx = listresource.NewQueryContext(ctx, &query)
+
+			var calls []bool
+			f := func(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+				calls = append(calls, request.IncludeResource)
+
+				query, _ := listresource.QueryFromContext(ctx)
+				if testCase.applyEagerly && query.NamePrefix != "" {
+					query.SetNamePrefixApplied()
+				}
+
+				stream.Results = func(yield func(list.ListResult) bool) {
+					if query.NamePrefix != "" {
+						query.SetNamePrefixApplied()
+					}
+				}
+			}
+
+			request := list.ListRequest{IncludeResource: testCase.includeResource}
+			var stream list.ListResultsStream
+			listWithQuery(ctx, &query, request, &stream, f)
+
+			if !slices.Equal(calls, testCase.expectedCalls) {
+				t.Errorf("List calls (IncludeResource): got %v, want %v", calls, testCase.expectedCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Query holds the standard list resource arguments of a List request.
+//
+// A list resource can push filters down to the service API that lists resources
+// and mark them as applied. Filters that are not marked as applied are evaluated
+// by the provider against each list result.
+type Query struct {
+	// NamePrefix restricts results to resources whose name begins with the prefix.
+	NamePrefix string
+	// TagFilters restrict results to resources whose tags match all the filters.
+	TagFilters []TagFilter
+	// IncludeResource indicates whether the full resource state was requested.
+	IncludeResource bool
+
+	namePrefixApplied bool
+	tagFiltersApplied []bool
+}
+
+// TagFilter matches resources that have no tags if Untagged is set,
+// otherwise resources that have the tag Key with any of Values (or any value if Values is empty).
+type TagFilter struct {
+	Key      string
+	Values   []string
+	Untagged bool
+}
+
+type tagFilterModel struct {
+	Key      types.String `tfsdk:"key"`
+	Untagged types.Bool   `tfsdk:"untagged"`
+	Values   types.List   `tfsdk:"values"`
+}
+
+type queryContextKeyType int
+
+var queryContextKey queryContextKeyType
+
+// NewQueryContext returns a new Context that carries the specified list query.
+func NewQueryContext(ctx context.Context, query *Query) context.Context {
+	return context.WithValue(ctx, queryContextKey, query)
+}
+
+// QueryFromContext returns the list query stored in the specified Context, if any.
+func QueryFromContext(ctx context.Context) (*Query, bool) {
+	query, ok := ctx.Value(queryContextKey).(*Query)
+	return query, ok
+}
+
+// InjectQueryArguments adds the standard filtering arguments to a list resource's configuration schema.
+// The "tag_filters" block is only added to list resources for tagged resource types.
+func InjectQueryArguments(schema *listschema.Schema, tagged bool) {
+	if schema.Attributes == nil {
+		schema.Attributes = make(map[string]listschema.Attribute)
+	}
+	if _, ok := schema.Attributes[names.AttrNamePrefix]; !ok {
+		schema.Attributes[names.AttrNamePrefix] = listresourceattribute.NamePrefix()
+	}
+
+	if !tagged {
+		return
+	}
+
+	if schema.Blocks == nil {
+		schema.Blocks = make(map[string]listschema.Block)
+	}
+	if _, ok := schema.Blocks[listresourceattribute.AttrTagFilters]; !ok {
+		schema.Blocks[listresourceattribute.AttrTagFilters] = listresourceattribute.TagFilters()
+	}
+}
+
+// NewQuery returns the list query for the specified List request along with the request to pass
+// to the list resource's own List handler.
+// The standard filtering arguments are removed from the request's configuration unless they are
+// declared in the list resource's own configuration schema, innerSchema.
+// The request's IncludeResource is left unchanged; see HasUnappliedFilters.
+func NewQuery(ctx context.Context, request list.ListRequest, innerSchema listschema.Schema) (*Query, list.ListRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	query := &Query{
+		IncludeResource: request.IncludeResource,
+	}
+
+	remove := make(map[string]bool)
+	if request.Config.Schema != nil {
+		if _, ok := request.Config.Schema.GetAttributes()[names.AttrNamePrefix]; ok {
+			if _, ok := innerSchema.Attributes[names.AttrNamePrefix]; !ok {
+				remove[names.AttrNamePrefix] = true
+			}
+		}
+		if _, ok := request.Config.Schema.GetBlocks()[listresourceattribute.AttrTagFilters]; ok {
+			if _, ok := innerSchema.Blocks[listresourceattribute.AttrTagFilters]; !ok {
+				remove[listresourceattribute.AttrTagFilters] = true
+			}
+		}
+	}
+
+	if len(remove) == 0 {
+		return query, request, diags
+	}
+
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if remove[names.AttrNamePrefix] {
+			var namePrefix types.String
+			diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrNamePrefix), &namePrefix)...)
+			if diags.HasError() {
+				return nil, request, diags
+			}
+			query.NamePrefix = namePrefix.ValueString()
+		}
+
+		if remove[listresourceattribute.AttrTagFilters] {
+			var tagFilters types.List
+			diags.Append(request.Config.GetAttribute(ctx, path.Root(listresourceattribute.AttrTagFilters), &tagFilters)...)
+			if diags.HasError() {
+				return nil, request, diags
+			}
+
+			if !tagFilters.IsNull() && !tagFilters.IsUnknown() {
+				var models []tagFilterModel
+				diags.Append(tagFilters.ElementsAs(ctx, &models, false)...)
+				if diags.HasError() {
+					return nil, request, diags
+				}
+
+				for i, model := range models {
+					tagFilter, d := expandTagFilter(ctx, path.Root(listresourceattribute.AttrTagFilters).AtListIndex(i), model)
+					diags.Append(d...)
+					if diags.HasError() {
+						return nil, request, diags
+					}
+
+					query.TagFilters = append(query.TagFilters, tagFilter)
+				}
+			}
+		}
+	}
+
+	config, d := removeConfigArguments(ctx, request.Config, remove)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, request, diags
+	}
+
+	request.Config = config
+	query.tagFiltersApplied = make([]bool, len(query.TagFilters))
+
+	return query, request, diags
+}
+
+func expandTagFilter(ctx context.Context, path path.Path, model tagFilterModel) (TagFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagFilter := TagFilter{
+		Key:      model.Key.ValueString(),
+		Untagged: model.Untagged.ValueBool(),
+	}
+
+	if !model.Values.IsNull() && !model.Values.IsUnknown() {
+		diags.Append(model.Values.ElementsAs(ctx, &tagFilter.Values, false)...)
+		if diags.HasError() {
+			return tagFilter, diags
+		}
+	}
+
+	switch {
+	case tagFilter.Untagged && (tagFilter.Key != "" || len(tagFilter.Values) > 0):
+		diags.AddAttributeError(path, "Invalid Attribute Combination", `"untagged" cannot be specified with "key" or "values".`)
+	case !tagFilter.Untagged && tagFilter.Key == "":
+		diags.AddAttributeError(path, "Missing Attribute", `One of "key" or "untagged" must be specified.`)
+	}
+
+	return tagFilter, diags
+}
+
+// removeConfigArguments returns a copy of the configuration without the specified top-level attributes and blocks.
+func removeConfigArguments(ctx context.Context, config tfsdk.Config, remove map[string]bool) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema, ok := config.Schema.(listschema.Schema)
+	if !ok {
+		diags.AddError(
+			"Internal Error",
+			"An unexpected error occurred. "+
+				"This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected list resource configuration schema, got: %T", config.Schema),
+		)
+		return config, diags
+	}
+
+	attributes := make(map[string]listschema.Attribute, len(schema.Attributes))
+	for k, v := range schema.Attributes {
+		if !remove[k] {
+			attributes[k] = v
+		}
+	}
+	blocks := make(map[string]listschema.Block, len(schema.Blocks))
+	for k, v := range schema.Blocks {
+		if !remove[k] {
+			blocks[k] = v
+		}
+	}
+	schema.Attributes = attributes
+	schema.Blocks = blocks
+
+	typ := schema.Type().TerraformType(ctx)
+	var raw tftypes.Value
+
+	switch {
+	case config.Raw.IsNull():
+		raw = tftypes.NewValue(typ, nil)
+	case !config.Raw.IsKnown():
+		raw = tftypes.NewValue(typ, tftypes.UnknownValue)
+	default:
+		var values map[string]tftypes.Value
+		if err := config.Raw.As(&values); err != nil {
+			diags.AddError("Reading List Resource Configuration", err.Error())
+			return config, diags
+		}
+
+		for k := range remove {
+			delete(values, k)
+		}
+
+		raw = tftypes.NewValue(typ, values)
+	}
+
+	return tfsdk.Config{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
+// SetNamePrefixApplied marks the name prefix filter as applied by the list resource.
+func (q *Query) SetNamePrefixApplied() {
+	q.namePrefixApplied = true
+}
+
+// SetTagFilterApplied marks the tag filter at the specified index as applied by the list resource.
+func (q *Query) SetTagFilterApplied(i int) {
+	if i >= 0 && i < len(q.tagFiltersApplied) {
+		q.tagFiltersApplied[i] = true
+	}
+}
+
+// HasUnappliedFilters returns whether any filters have not been applied by the list resource.
+// These filters are evaluated by the provider against each result's resource state,
+// so the full resource state must be requested from the list resource.
+func (q *Query) HasUnappliedFilters() bool {
+	if q.NamePrefix != "" && !q.namePrefixApplied {
+		return true
+	}
+
+	return slices.Contains(q.tagFiltersApplied, false)
+}
+
+// Filter returns the results that match the filters not applied by the list resource.
+// Results without resource state, for example diagnostics, are passed through unchanged.
+func (q *Query) Filter(ctx context.Context, results iter.Seq[list.ListResult]) iter.Seq[list.ListResult] {
+	if !q.HasUnappliedFilters() {
+		return results
+	}
+
+	return func(yield func(list.ListResult) bool) {
+		for result := range results {
+			if !result.Diagnostics.HasError() && result.Resource != nil && !result.Resource.Raw.IsNull() {
+				ok, err := q.match(result)
+				if err != nil {
+					result.Diagnostics.AddError("Filtering List Results", err.Error())
+				} else if !ok {
+					continue
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func (q *Query) match(result list.ListResult) (bool, error) {
+	var values map[string]tftypes.Value
+	if err := result.Resource.Raw.As(&values); err != nil {
+		return false, err
+	}
+
+	tags, err := resourceTags(values)
+	if err != nil {
+		return false, err
+	}
+
+	if q.NamePrefix != "" && !q.namePrefixApplied {
+		name, err := resourceName(values, tags, result.DisplayName)
+		if err != nil {
+			return false, err
+		}
+
+		if !strings.HasPrefix(name, q.NamePrefix) {
+			return false, nil
+		}
+	}
+
+	for i, tagFilter := range q.TagFilters {
+		if q.tagFiltersApplied[i] {
+			continue
+		}
+
+		if !tagFilter.match(tags) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (f TagFilter) match(tags map[string]string) bool {
+	if f.Untagged {
+		return len(tags) == 0
+	}
+
+	v, ok := tags[f.Key]
+	if !ok {
+		return false
+	}
+
+	return len(f.Values) == 0 || slices.Contains(f.Values, v)
+}
+
+// resourceName returns the name of a resource.
+// This is the value of the resource's "name" attribute if it has one, otherwise its "Name" tag,
+// falling back to the list result's display name.
+func resourceName(values map[string]tftypes.Value, tags map[string]string, displayName string) (string, error) {
+	if v, ok := values[names.AttrName]; ok && v.IsKnown() && !v.IsNull() && v.Type().Is(tftypes.String) {
+		var name string
+		if err := v.As(&name); err != nil {
+			return "", err
+		}
+
+		if name != "" {
+			return name, nil
+		}
+	}
+
+	if name, ok := tags["Name"]; ok {
+		return name, nil
+	}
+
+	return displayName, nil
+}
+
+// resourceTags returns a resource's tags, including any provider default tags.
+func resourceTags(values map[string]tftypes.Value) (map[string]string, error) {
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		v, ok := values[k]
+		if !ok || !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		tags := make(map[string]string, len(elems))
+		for key, elem := range elems {
+			if !elem.IsKnown() || elem.IsNull() {
+				continue
+			}
+
+			var value string
+			if err := elem.As(&value); err != nil {
+				return nil, err
+			}
+			tags[key] = value
+		}
+
+		return tags, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,370 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package listresource
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	innerSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"include_default": listschema.BoolAttribute{Optional: true},
+		},
+	}
+
+	tagFilterType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrKey:                      tftypes.String,
+			listresourceattribute.AttrUntagged: tftypes.Bool,
+			names.AttrValues:                   tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	tagFilter := func(key string, untagged bool, values ...string) tftypes.Value {
+		k := tftypes.NewValue(tftypes.String, nil)
+		if key != "" {
+			k = tftypes.NewValue(tftypes.String, key)
+		}
+		u := tftypes.NewValue(tftypes.Bool, nil)
+		if untagged {
+			u = tftypes.NewValue(tftypes.Bool, true)
+		}
+		v := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+		if len(values) > 0 {
+			var elems []tftypes.Value
+			for _, value := range values {
+				elems = append(elems, tftypes.NewValue(tftypes.String, value))
+			}
+			v = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems)
+		}
+		return tftypes.NewValue(tagFilterType, map[string]tftypes.Value{
+			names.AttrKey:                      k,
+			listresourceattribute.AttrUntagged: u,
+			names.AttrValues:                   v,
+		})
+	}
+
+	testCases := map[string]struct {
+		namePrefix      string
+		tagFilters      []tftypes.Value
+		expectedQuery   Query
+		expectError     bool
+		expectInclude   bool
+		includeResource bool
+	}{
+		"no filters": {
+			expectedQuery: Query{},
+		},
+		"name prefix": {
+			namePrefix: "tf-acc-",
+			expectedQuery: Query{
+				NamePrefix: "tf-acc-",
+			},
+		},
+		"tag filters": {
+			tagFilters: []tftypes.Value{
+				tagFilter("Environment", false, "dev", "test"),
+				tagFilter("Owner", false),
+			},
+			expectedQuery: Query{
+				TagFilters: []TagFilter{
+					{Key: "Environment", Values: []string{"dev", "test"}},
+					{Key: "Owner"},
+				},
+			},
+		},
+		"untagged": {
+			tagFilters: []tftypes.Value{
+				tagFilter("", true),
+			},
+			expectedQuery: Query{
+				TagFilters: []TagFilter{
+					{Untagged: true},
+				},
+			},
+		},
+		"include resource": {
+			includeResource: true,
+			expectedQuery: Query{
+				IncludeResource: true,
+			},
+			expectInclude: true,
+		},
+		"untagged with key": {
+			tagFilters: []tftypes.Value{
+				tagFilter("Owner", true),
+			},
+			expectError: true,
+		},
+		"no key": {
+			tagFilters: []tftypes.Value{
+				tagFilter("", false, "dev"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			configSchema := innerSchema
+			configSchema.Attributes = map[string]listschema.Attribute{
+				"include_default": listschema.BoolAttribute{Optional: true},
+				names.AttrRegion:  listresourceattribute.Region(),
+			}
+			InjectQueryArguments(&configSchema, true)
+
+			typ := configSchema.Type().TerraformType(ctx)
+			namePrefix := tftypes.NewValue(tftypes.String, nil)
+			if testCase.namePrefix != "" {
+				namePrefix = tftypes.NewValue(tftypes.String, testCase.namePrefix)
+			}
+			request := list.ListRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(typ, map[string]tftypes.Value{
+						"include_default":                    tftypes.NewValue(tftypes.Bool, true),
+						names.AttrRegion:                     tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+						names.AttrNamePrefix:                 namePrefix,
+						listresourceattribute.AttrTagFilters: tftypes.NewValue(tftypes.List{ElementType: tagFilterType}, testCase.tagFilters),
+					}),
+					Schema: configSchema,
+				},
+				IncludeResource: testCase.includeResource,
+			}
+
+			query, request, diags := NewQuery(ctx, request, innerSchema)
+
+			if testCase.expectError {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %s", diags)
+			}
+
+			if got, want := query.NamePrefix, testCase.expectedQuery.NamePrefix; got != want {
+				t.Errorf("NamePrefix: got %q, want %q", got, want)
+			}
+			if got, want := query.TagFilters, testCase.expectedQuery.TagFilters; !slices.EqualFunc(got, want, func(a, b TagFilter) bool {
+				return a.Key == b.Key && a.Untagged == b.Untagged && slices.Equal(a.Values, b.Values)
+			}) {
+				t.Errorf("TagFilters: got %v, want %v", got, want)
+			}
+			if got, want := query.IncludeResource, testCase.expectedQuery.IncludeResource; got != want {
+				t.Errorf("IncludeResource: got %t, want %t", got, want)
+			}
+			if got, want := request.IncludeResource, testCase.expectInclude; got != want {
+				t.Errorf("request IncludeResource: got %t, want %t", got, want)
+			}
+
+			var values map[string]tftypes.Value
+			if err := request.Config.Raw.As(&values); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for _, k := range []string{names.AttrNamePrefix, listresourceattribute.AttrTagFilters} {
+				if _, ok := values[k]; ok {
+					t.Errorf("expected %q to be removed from configuration", k)
+				}
+			}
+			for _, k := range []string{"include_default", names.AttrRegion} {
+				if _, ok := values[k]; !ok {
+					t.Errorf("expected %q to be kept in configuration", k)
+				}
+			}
+		})
+	}
+}
+
+func TestQueryFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{Optional: true},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTagsAll: schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+
+	newResult := func(name string, tags map[string]string) list.ListResult {
+		tagsAll := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+		if tags != nil {
+			m := make(map[string]tftypes.Value, len(tags))
+			for k, v := range tags {
+				m[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			tagsAll = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, m)
+		}
+
+		return list.ListResult{
+			DisplayName: name,
+			Resource: &tfsdk.Resource{
+				Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					names.AttrName:    tftypes.NewValue(tftypes.String, name),
+					names.AttrTags:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					names.AttrTagsAll: tagsAll,
+				}),
+				Schema: resourceSchema,
+			},
+		}
+	}
+
+	results := []list.ListResult{
+		newResult("tf-acc-1", map[string]string{"Environment": "dev"}),
+		newResult("tf-acc-2", map[string]string{"Environment": "prod", "Owner": "me"}),
+		newResult("other", map[string]string{"Owner": "you"}),
+		newResult("tf-acc-3", nil),
+	}
+
+	testCases := map[string]struct {
+		query    Query
+		applied  func(*Query)
+		expected []string
+	}{
+		"no filters": {
+			expected: []string{"tf-acc-1", "tf-acc-2", "other", "tf-acc-3"},
+		},
+		"name prefix": {
+			query:    Query{NamePrefix: "tf-acc-"},
+			expected: []string{"tf-acc-1", "tf-acc-2", "tf-acc-3"},
+		},
+		"name prefix applied": {
+			query:    Query{NamePrefix: "tf-acc-"},
+			applied:  (*Query).SetNamePrefixApplied,
+			expected: []string{"tf-acc-1", "tf-acc-2", "other", "tf-acc-3"},
+		},
+		"tag key": {
+			query:    Query{TagFilters: []TagFilter{{Key: "Owner"}}},
+			expected: []string{"tf-acc-2", "other"},
+		},
+		"tag values": {
+			query:    Query{TagFilters: []TagFilter{{Key: "Environment", Values: []string{"dev", "test"}}}},
+			expected: []string{"tf-acc-1"},
+		},
+		"tag filters": {
+			query:    Query{TagFilters: []TagFilter{{Key: "Environment"}, {Key: "Owner", Values: []string{"me"}}}},
+			expected: []string{"tf-acc-2"},
+		},
+		"tag filter applied": {
+			query: Query{TagFilters: []TagFilter{{Key: "Environment"}, {Key: "Owner", Values: []string{"me"}}}},
+			applied: func(q *Query) {
+				q.SetTagFilterApplied(1)
+			},
+			expected: []string{"tf-acc-1", "tf-acc-2"},
+		},
+		"untagged": {
+			query:    Query{TagFilters: []TagFilter{{Untagged: true}}},
+			expected: []string{"tf-acc-3"},
+		},
+		"name prefix and tag key": {
+			query:    Query{NamePrefix: "tf-acc-", TagFilters: []TagFilter{{Key: "Owner"}}},
+			expected: []string{"tf-acc-2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query := testCase.query
+			query.tagFiltersApplied = make([]bool, len(query.TagFilters))
+			if testCase.applied != nil {
+				testCase.applied(&query)
+			}
+
+			var got []string
+			for result := range query.Filter(ctx, slices.Values(results)) {
+				if result.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %s", result.Diagnostics)
+				}
+				got = append(got, result.DisplayName)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestQueryHasUnappliedFilters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query    Query
+		applied  func(*Query)
+		expected bool
+	}{
+		"no filters": {
+			expected: false,
+		},
+		"name prefix": {
+			query:    Query{NamePrefix: "tf-acc-"},
+			expected: true,
+		},
+		"name prefix applied": {
+			query:    Query{NamePrefix: "tf-acc-"},
+			applied:  (*Query).SetNamePrefixApplied,
+			expected: false,
+		},
+		"tag filter applied": {
+			query: Query{TagFilters: []TagFilter{{Key: "Environment"}, {Key: "Owner"}}},
+			applied: func(q *Query) {
+				q.SetTagFilterApplied(1)
+			},
+			expected: true,
+		},
+		"all applied": {
+			query: Query{NamePrefix: "tf-acc-", TagFilters: []TagFilter{{Key: "Environment"}, {Key: "Owner"}}},
+			applied: func(q *Query) {
+				q.SetNamePrefixApplied()
+				q.SetTagFilterApplied(0)
+				q.SetTagFilterApplied(1)
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			query := testCase.query
+			query.tagFiltersApplied = make([]bool, len(query.TagFilters))
+			if testCase.applied != nil {
+				testCase.applied(&query)
+			}
+
+			if got, want := query.HasUnappliedFilters(), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -7,12 +7,48 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	AttrTagFilters = "tag_filters"
+	AttrUntagged   = "untagged"
+)
+
 var Region = sync.OnceValue(func() schema.StringAttribute {
 	return schema.StringAttribute{
 		Optional:    true,
 		Description: names.ListResourceTopLevelRegionAttributeDescription,
 	}
 })
+
+var NamePrefix = sync.OnceValue(func() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Only list resources whose name begins with this prefix.",
+	}
+})
+
+var TagFilters = sync.OnceValue(func() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "Only list resources whose tags match all of the filters.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Optional:    true,
+					Description: "Tag key that resources must have.",
+				},
+				AttrUntagged: schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to only list resources that have no tags. Conflicts with `key` and `values`.",
+				},
+				names.AttrValues: schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Tag values, one of which the tag must have. Any value matches if not set.",
+				},
+			},
+		},
+	}
+})
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
+)
+
+// resourceValidatePolicyDocuments statically checks the IAM policy documents in a resource's top-level IAMPolicyType attributes.
+func resourceValidatePolicyDocuments() resourceModifyPlanInterceptor {
+	return &resourceValidatePolicyDocumentsInterceptor{}
+}
+
+type resourceValidatePolicyDocumentsInterceptor struct{}
+
+func (r resourceValidatePolicyDocumentsInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	config := c.PolicyLintConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+	typeName := inContext.TypeName()
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		isCreate := request.State.Raw.IsNull()
+
+		attributes := request.Plan.Schema.GetAttributes()
+		for _, k := range slices.Sorted(maps.Keys(attributes)) {
+			if !fwtypes.IAMPolicyType.Equal(attributes[k].GetType()) {
+				continue
+			}
+
+			var planPolicy, statePolicy fwtypes.IAMPolicy
+			response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(k), &planPolicy)...)
+			if !isCreate {
+				response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(k), &statePolicy)...)
+			}
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if planPolicy.IsNull() || planPolicy.IsUnknown() {
+				continue
+			}
+
+			if !isCreate && planPolicy.Equal(statePolicy) {
+				continue
+			}
+
+			for _, finding := range policylint.Lint(planPolicy.ValueString(), policylint.SizeLimit(typeName)) {
+				switch config.Severity {
+				case "warning":
+					response.Diagnostics.AddAttributeWarning(path.Root(k), finding.Summary, finding.Detail)
+				default:
+					response.Diagnostics.AddAttributeError(path.Root(k), finding.Summary, finding.Detail)
+				}
+			}
+		}
+	}
+}
@@ -37,6 +37,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -145,6 +146,14 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"policy_lint": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report problems found by statically checking IAM policy documents during plan. ` +
+					`Checks include unknown actions, invalid condition operators, malformed ARNs, unconditional public access, and policy size limits. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", policy documents are not linted. ` +
+					`Can also be configured with the ` + policylint.EnvVar + ` environment variable.`,
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -209,6 +218,18 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_file": schema.StringAttribute{
+				Optional: true,
+				Description: `Path to a local organizational tag policy JSON document, in the AWS Organizations tag policy format. ` +
+					`When set, required tags, allowed tag values, and tag key capitalization are read from this file ` +
+					`instead of being retrieved from AWS, and are enforced according to tag_policy_compliance. ` +
+					`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+			},
+			"tags_provenance": schema.BoolAttribute{
+				Optional: true,
+				Description: `Report the source of every key in tags_all (default_tags, the resource's tags, or removed by ignore_tags) ` +
+					`when a resource's tags are planned to change.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -230,6 +251,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Product details to append to the User-Agent string sent in all AWS API calls.",
 			},
+			"verify_tags_on_create": schema.BoolAttribute{
+				Optional: true,
+				Description: `Re-read a resource's tags after it is created and warn about any tags that were sent ` +
+					`but not applied by AWS.`,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
@@ -329,6 +355,15 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": tagsRuleBlock("Configuration block with resource tags to default across a subset of resources.", map[string]schema.Attribute{
+							"tags": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tags to default across the selected resources.",
+							},
+						}),
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -352,14 +387,82 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"rule": tagsRuleBlock("Configuration block with settings to ignore resource tags across a subset of resources.", map[string]schema.Attribute{
+							"key_prefixes": schema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tag key prefixes to ignore across the selected resources.",
+							},
+							"keys": schema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Resource tag keys to ignore across the selected resources.",
+							},
+						}),
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "Configuration block(s) with client-side limits on requests to an AWS service, or to a single API operation of a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrency": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent requests. When unset or 0, concurrency is not limited.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The API operation name, e.g. `ChangeResourceRecordSets`. When unset, the limits apply to all of the service's operations together.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum sustained number of requests per second. When unset or 0, the request rate is not limited.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service package name, e.g. `route53`.",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
+// tagsRuleBlock returns the schema for a default_tags or ignore_tags rule configuration block,
+// adding the resource selector attributes to the specified attributes.
+func tagsRuleBlock(description string, attributes map[string]schema.Attribute) schema.Block {
+	attributes["exclude_resource_types"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Resource type name patterns, such as `aws_ec2_*`, the rule does not apply to. Takes precedence over include_resource_types.",
+	}
+	attributes["include_resource_types"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Resource type name patterns, such as `aws_ec2_*`, the rule applies to. When unset, the rule applies to all resource types.",
+	}
+	attributes["services"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Service package names, such as `ec2`, the rule applies to. When unset, the rule applies to all services.",
+	}
+
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
 func (p *frameworkProvider) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
-	resp.Schema = metaschema.Schema{
+	resp.Schema = providerMetaSchema()
+}
+
+func providerMetaSchema() metaschema.Schema {
+	return metaschema.Schema{
 		Attributes: map[string]metaschema.Attribute{
 			"user_agent": schema.ListAttribute{
 				ElementType: types.StringType,
@@ -423,7 +526,17 @@ func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action
 func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchFunction,
+		tffunction.NewARNNormalizeFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSubnetsForAZsFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewPartitionOfFunction,
+		tffunction.NewPolicyEvaluateFunction,
+		tffunction.NewPolicyMergeFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
@@ -455,9 +568,15 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 		}
 
 		if v, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			resourceSpecs := make(map[string]*inttypes.ServicePackageFrameworkResource)
+			for _, resourceSpec := range sp.FrameworkResources(ctx) {
+				resourceSpecs[resourceSpec.TypeName] = resourceSpec
+			}
+
 			for listResourceSpec := range v.FrameworkListResources(ctx) {
+				resourceSpec := resourceSpecs[listResourceSpec.TypeName]
 				p.listResources = append(p.listResources, func() list.ListResource { //nolint:contextcheck // must be a func()
-					return newWrappedListResourceFramework(listResourceSpec, servicePackageName)
+					return newWrappedListResourceFramework(listResourceSpec, resourceSpec, servicePackageName)
 				})
 			}
 		}
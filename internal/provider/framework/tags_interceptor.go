@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -87,7 +88,7 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		return
 	}
 
-	sp, _, _, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return
 	}
@@ -114,6 +115,34 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		if opts.response.Diagnostics.HasError() {
 			return
 		}
+
+		// Optionally re-read tags and report any that were silently dropped.
+		if config := c.TagsReportingConfig(ctx); config != nil && config.VerifyOnCreate {
+			identifier := r.GetIdentifierFramework(ctx, response.State)
+			if identifier == "" {
+				return
+			}
+
+			if tagsInContext.TagsOut.IsNone() {
+				if err := r.ListTags(ctx, sp, c, identifier); err != nil {
+					opts.response.Diagnostics.AddWarning(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+					return
+				}
+			}
+
+			// Tags can't be verified if the service package has no ListTags method.
+			if tagsInContext.TagsOut.IsNone() {
+				return
+			}
+
+			if notApplied := tagsInContext.TagsIn.MustUnwrap().NotApplied(tagsInContext.TagsOut.UnwrapOrDefault()); len(notApplied) > 0 {
+				keys := notApplied.Keys()
+				slices.Sort(keys)
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTagsAll), "Tags Not Applied",
+					fmt.Sprintf("The following tags were sent when creating %s %s (%s) but were not applied: %s", serviceName, resourceName, identifier, strings.Join(keys, ", ")))
+			}
+		}
 	}
 }
 
@@ -244,6 +273,21 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 		if planTags.IsWhollyKnown() {
 			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			if config := c.TagsReportingConfig(ctx); config != nil && config.Provenance {
+				var stateTagsAll tftags.Map
+				if !request.State.Raw.IsNull() {
+					opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+					if opts.response.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				// Only report when tags_all is planned to change.
+				if provenance := tftags.Provenance(c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), tftags.New(ctx, planTags)); len(provenance) > 0 && (request.State.Raw.IsNull() || !allTags.Equal(tftags.New(ctx, stateTagsAll))) {
+					opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTagsAll), "Tags Provenance", provenance.String())
+				}
+			}
 		} else {
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
@@ -282,8 +326,9 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 	if policy == nil {
 		return
 	}
-	reqTags, ok := policy.RequiredTags[typeName]
-	if !ok {
+	reqTags, hasReqTags := policy.RequiredTags[typeName]
+	_, hasRules := policy.EnforcedTagRules[typeName]
+	if !hasReqTags && !hasRules {
 		return
 	}
 
@@ -315,21 +360,32 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
+		addDiagnostic := func(p path.Path, summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(p, summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(p, summary, detail)
+			}
 		}
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
+		if !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
 
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			summary := "Missing Required Tags"
+			detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
+			addDiagnostic(path.Root(names.AttrTags), summary, detail)
+		}
 
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		resourceTags := tftags.New(ctx, planTags)
+		for _, v := range policy.Violations(typeName, allPlanTags) {
+			// Tags inherited from default_tags are reported against the resource's tags attribute.
+			p := path.Root(names.AttrTags)
+			if resourceTags.KeyExists(v.Key) {
+				p = p.AtMapKey(v.Key)
+			}
+			addDiagnostic(p, "Noncompliant Tag", v.Detail)
 		}
 	}
 }
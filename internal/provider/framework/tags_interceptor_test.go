@@ -44,6 +44,11 @@ func (c mockRequiredTagsClient) TagPolicyConfig(ctx context.Context) *tftags.Tag
 				"bar": nil,
 			},
 		},
+		EnforcedTagRules: map[string][]tftags.TagPolicyRule{
+			"aws_test": {
+				{Key: "foo", Values: []string{"allowed"}},
+			},
+		},
 	}
 }
 
@@ -117,6 +122,16 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 	}
 	rawValRequired := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsRequired)
 
+	// Noncompliant tag value
+	attrsNoncompliant := map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"foo": tftypes.NewValue(tftypes.String, "denied"),
+			"bar": tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+	rawValNoncompliant := tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), attrsNoncompliant)
+
 	// Unknown tag values
 	attrsUnknown := map[string]tftypes.Value{
 		"name": tftypes.NewValue(tftypes.String, "test"),
@@ -165,6 +180,39 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 			),
 			},
 		},
+		{
+			name: "create, noncompliant tag value",
+			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: mockRequiredTagsClient{},
+				request: &resource.ModifyPlanRequest{
+					Config: tfsdk.Config{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+					State: tfsdk.State{
+						Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil), // Raw state is null on creation
+						Schema: resourceSchema,
+					},
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tfsdk.Plan{
+						Raw:    rawValNoncompliant,
+						Schema: resourceSchema,
+					},
+				},
+				when: Before,
+			},
+			wantDiags: diag.Diagnostics{diag.NewAttributeErrorDiagnostic(
+				path.Root(names.AttrTags).AtMapKey("foo"),
+				"Noncompliant Tag",
+				`An organizational tag policy does not allow value "denied" for tag "foo" on aws_test; allowed values are ["allowed"]`,
+			),
+			},
+		},
 		{
 			name: "create, partial tags",
 			opts: interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+// resourceReportWaitWarnings reports long-running waits for state changes as warnings.
+func resourceReportWaitWarnings() resourceCRUDInterceptor {
+	return &resourceReportWaitWarningsInterceptor{}
+}
+
+type resourceReportWaitWarningsInterceptor struct {
+	resourceNoOpCRUDInterceptor
+}
+
+func (r resourceReportWaitWarningsInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	if opts.when == Finally {
+		opts.response.Diagnostics.Append(waitWarnings(ctx)...)
+	}
+}
+
+func (r resourceReportWaitWarningsInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	if opts.when == Finally {
+		opts.response.Diagnostics.Append(waitWarnings(ctx)...)
+	}
+}
+
+func (r resourceReportWaitWarningsInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	if opts.when == Finally {
+		opts.response.Diagnostics.Append(waitWarnings(ctx)...)
+	}
+}
+
+func waitWarnings(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, warning := range retry.WarningsFromContext(ctx) {
+		diags.AddWarning(warning.Summary, warning.Detail)
+	}
+
+	return diags
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
@@ -567,6 +568,8 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	interceptors = append(interceptors, resourceValidatePolicyDocuments())
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -622,6 +625,7 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = retry.NewWarningsContext(ctx)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -844,12 +848,13 @@ type wrappedListResourceFramework struct {
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageFrameworkListResource
+	resourceSpec       *inttypes.ServicePackageFrameworkResource
 	interceptors       interceptorInvocations
 }
 
 var _ list.ListResourceWithConfigure = &wrappedListResourceFramework{}
 
-func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListResource, servicePackageName string) list.ListResourceWithConfigure {
+func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListResource, resourceSpec *inttypes.ServicePackageFrameworkResource, servicePackageName string) list.ListResourceWithConfigure {
 	var interceptors interceptorInvocations
 
 	var isRegionOverrideEnabled bool
@@ -862,6 +867,8 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
+	interceptors = append(interceptors, listResourceInjectQueryArguments(!tfunique.IsHandleNil(spec.Tags)))
+
 	inner := spec.Factory()
 
 	if v, ok := inner.(framework.Identityer); ok {
@@ -887,6 +894,7 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 		inner:              inner,
 		servicePackageName: servicePackageName,
 		spec:               spec,
+		resourceSpec:       resourceSpec,
 		interceptors:       interceptors,
 	}
 }
@@ -950,7 +958,29 @@ func (w *wrappedListResourceFramework) List(ctx context.Context, request list.Li
 		return
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	query, request, diags := newListQuery(ctx, w.inner, request)
+	if len(diags) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
+	}
+	if diags.HasError() {
+		return
+	}
+	ctx = listresource.NewQueryContext(ctx, query)
+
+	listWithQuery(ctx, query, request, stream, interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta))
+
+	// Hydrate the full resource state using the resource's own Read.
+	if query.IncludeResource && w.resourceSpec != nil {
+		r := newWrappedResource(w.resourceSpec, w.servicePackageName)
+		var response resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: w.meta}, &response)
+		if response.Diagnostics.HasError() {
+			stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(response.Diagnostics))
+			return
+		}
+
+		stream.Results = readListResults(ctx, r, stream.Results)
+	}
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -988,6 +1018,8 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
+	interceptors = append(interceptors, listResourceInjectQueryArguments(!tfunique.IsHandleNil(spec.Tags)))
+
 	inner := spec.Factory()
 
 	if v, ok := inner.(framework.WithRegionSpec); ok {
@@ -1077,7 +1109,17 @@ func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequ
 		return
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	query, request, diags := newListQuery(ctx, w.inner, request)
+	if len(diags) > 0 {
+		stream.Results = tfiter.Concat(stream.Results, list.ListResultsStreamDiagnostics(diags))
+	}
+	if diags.HasError() {
+		return
+	}
+	ctx = listresource.NewQueryContext(ctx, query)
+
+	// The full resource state is hydrated by the inner list resource, see framework.ListResourceWithSDKv2Resource.
+	listWithQuery(ctx, query, request, stream, interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta))
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -311,10 +312,18 @@ func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) TagsReportingConfig(ctx context.Context) *tftags.ReportingConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) PolicyLintConfig(context.Context) *policylint.Config {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CustomizeDiff does not support diagnostics (only an error return),
+// so warnings raised while planning are collected in the request context and added to the PlanResourceChange response.
+
+type planWarningsKey struct{}
+
+type planWarnings struct {
+	sync.Mutex
+	values []*tfprotov5.Diagnostic
+}
+
+func newPlanWarningsContext(ctx context.Context) (context.Context, *planWarnings) {
+	w := &planWarnings{}
+
+	return context.WithValue(ctx, planWarningsKey{}, w), w
+}
+
+// addPlanWarning adds a warning, optionally about a top-level attribute, to the plan being made with the specified context.
+// Returns false if the context is not that of a plan.
+func addPlanWarning(ctx context.Context, attribute, summary, detail string) bool {
+	w, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		return false
+	}
+
+	diagnostic := &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	}
+	if attribute != "" {
+		diagnostic.Attribute = tftypes.NewAttributePath().WithAttributeName(attribute)
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	w.values = append(w.values, diagnostic)
+
+	return true
+}
+
+// planWarningsProviderServer is a Plugin SDK provider server that reports plan warnings.
+type planWarningsProviderServer struct {
+	*schema.GRPCProviderServer
+}
+
+func (s *planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, w := newPlanWarningsContext(ctx)
+
+	response, err := s.GRPCProviderServer.PlanResourceChange(ctx, request)
+
+	if response != nil {
+		w.Lock()
+		response.Diagnostics = append(response.Diagnostics, w.values...)
+		w.Unlock()
+	}
+
+	return response, err
+}
+
+// GRPCProvider returns a terraform-plugin-go protocol v5 provider server for the specified Plugin SDK provider.
+// Unlike the Plugin SDK's own server, warnings raised while planning are reported.
+func GRPCProvider(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &planWarningsProviderServer{
+			GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAddPlanWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if addPlanWarning(ctx, "tags_all", "Summary", "Detail") {
+		t.Error("addPlanWarning() outside a plan = true, want false")
+	}
+
+	ctx, w := newPlanWarningsContext(ctx)
+
+	if !addPlanWarning(ctx, "tags_all", "Summary 1", "Detail 1") {
+		t.Error("addPlanWarning() = false, want true")
+	}
+	if !addPlanWarning(ctx, "", "Summary 2", "Detail 2") {
+		t.Error("addPlanWarning() = false, want true")
+	}
+
+	if got, want := len(w.values), 2; got != want {
+		t.Fatalf("length of warnings = %d, want %d", got, want)
+	}
+
+	if got, want := w.values[0].Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("Severity = %v, want %v", got, want)
+	}
+	if got, want := w.values[0].Attribute, tftypes.NewAttributePath().WithAttributeName("tags_all"); !got.Equal(want) {
+		t.Errorf("Attribute = %v, want %v", got, want)
+	}
+	if got := w.values[1].Attribute; got != nil {
+		t.Errorf("Attribute = %v, want nil", got)
+	}
+	if got, want := w.values[1].Summary, "Summary 2"; got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
+)
+
+// policyDiffSuppressFuncs are the DiffSuppressFuncs that identify an attribute as holding an IAM policy document.
+var policyDiffSuppressFuncs = []uintptr{
+	reflect.ValueOf(schema.SchemaDiffSuppressFunc(verify.SuppressEquivalentPolicyDiffs)).Pointer(),
+	reflect.ValueOf(schema.SchemaDiffSuppressFunc(sdkv2.SuppressEquivalentIAMPolicyDocuments)).Pointer(),
+}
+
+// policyAttributes returns the sorted names of the top-level string attributes that hold IAM policy documents.
+func policyAttributes(s map[string]*schema.Schema) []string {
+	var attributes []string
+
+	for k, v := range s {
+		if v.Type != schema.TypeString || v.DiffSuppressFunc == nil {
+			continue
+		}
+
+		if slices.Contains(policyDiffSuppressFuncs, reflect.ValueOf(v.DiffSuppressFunc).Pointer()) {
+			attributes = append(attributes, k)
+		}
+	}
+
+	slices.Sort(attributes)
+
+	return attributes
+}
+
+// validatePolicyDocuments statically checks the IAM policy documents in the specified attributes.
+func validatePolicyDocuments(attributes []string) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.PolicyLintConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+		typeName := inContext.TypeName()
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				isCreate := d.GetRawState().IsNull()
+
+				var errs []error
+				for _, k := range attributes {
+					if !isCreate && !d.HasChange(k) {
+						continue
+					}
+
+					if v := d.GetRawPlan().GetAttr(k); !v.IsKnown() || v.IsNull() {
+						continue
+					}
+
+					for _, finding := range policylint.Lint(d.Get(k).(string), policylint.SizeLimit(typeName)) {
+						switch config.Severity {
+						case "warning":
+							if !addPlanWarning(ctx, k, finding.Summary, finding.Detail) {
+								tflog.Warn(ctx, "IAM Policy Lint", map[string]any{
+									"attribute": k,
+									"summary":   finding.Summary,
+									"detail":    finding.Detail,
+								})
+							}
+						default:
+							errs = append(errs, fmt.Errorf("%s: %s", k, finding))
+						}
+					}
+				}
+
+				return errors.Join(errs...)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		names.AttrPolicy: {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		"assume_role_policy": sdkv2.IAMPolicyDocumentSchemaRequired(),
+		"tags_json": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		},
+	}
+
+	if got, want := policyAttributes(s), []string{"assume_role_policy", names.AttrPolicy}; !slices.Equal(got, want) {
+		t.Errorf("policyAttributes() = %v, want %v", got, want)
+	}
+}
@@ -30,11 +30,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -97,6 +100,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"rule": tagsRuleSchema("Configuration block with resource tags to default across a subset of resources.", map[string]*schema.Schema{
+								"tags": {
+									Type:        schema.TypeMap,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tags to default across the selected resources.",
+								},
+							}),
 						},
 					},
 				},
@@ -152,6 +163,20 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"rule": tagsRuleSchema("Configuration block with settings to ignore resource tags across a subset of resources.", map[string]*schema.Schema{
+								"keys": {
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tag keys to ignore across the selected resources.",
+								},
+								"key_prefixes": {
+									Type:        schema.TypeSet,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Resource tag key prefixes to ignore across the selected resources.",
+								},
+							}),
 						},
 					},
 				},
@@ -174,12 +199,53 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"policy_lint": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report problems found by statically checking IAM policy documents during plan. ` +
+						`Checks include unknown actions, invalid condition operators, malformed ARNs, unconditional public access, and policy size limits. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", policy documents are not linted. ` +
+						`Can also be configured with the ` + policylint.EnvVar + ` environment variable.`,
+				},
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"rate_limits": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration block(s) with client-side limits on requests to an AWS service, or to a single API operation of a service.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_concurrency": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The maximum number of concurrent requests. When unset or 0, concurrency is not limited.",
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The API operation name, e.g. `ChangeResourceRecordSets`. When unset, the limits apply to all of the service's operations together.",
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatAtLeast(0),
+								Description:  "The maximum sustained number of requests per second. When unset or 0, the request rate is not limited.",
+							},
+							"service": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+								Description:  "The service package name, e.g. `route53`.",
+							},
+						},
+					},
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -265,6 +331,20 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `Path to a local organizational tag policy JSON document, in the AWS Organizations tag policy format. ` +
+						`When set, required tags, allowed tag values, and tag key capitalization are read from this file ` +
+						`instead of being retrieved from AWS, and are enforced according to tag_policy_compliance. ` +
+						`Can also be configured with the ` + tftags.TagPolicyFileEnvVar + ` environment variable.`,
+				},
+				"tags_provenance": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: `Report the source of every key in tags_all (default_tags, the resource's tags, or removed by ignore_tags) ` +
+						`when a resource's tags are planned to change.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -292,6 +372,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Product details to append to the User-Agent string sent in all AWS API calls.",
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
+				"verify_tags_on_create": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: `Re-read a resource's tags after it is created and warn about any tags that were sent ` +
+						`but not applied by AWS.`,
+				},
 			},
 
 			// ProviderMetaSchema enables module-scoped User-Agent modifications
@@ -503,6 +589,27 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		return nil, diags
 	}
 	config.TagPolicyConfig = tagCfg
+	if v, ok := d.Get("tag_policy_file").(string); ok && v != "" {
+		config.TagPolicyFile = v
+	} else {
+		config.TagPolicyFile = os.Getenv(tftags.TagPolicyFileEnvVar)
+	}
+
+	policyLintCfg, dg := expandPolicyLintConfig(cty.GetAttrPath("policy_lint"), d.Get("policy_lint").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.PolicyLintConfig = policyLintCfg
+
+	if v, ok := d.GetOk("rate_limits"); ok && len(v.([]any)) > 0 {
+		config.RateLimits = expandRateLimits(v.([]any))
+	}
+
+	config.TagsReportingConfig = &tftags.ReportingConfig{
+		Provenance:     d.Get("tags_provenance").(bool),
+		VerifyOnCreate: d.Get("verify_tags_on_create").(bool),
+	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
@@ -762,6 +869,19 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: validateRequiredTags(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: reportTagsProvenance(),
+				})
+			}
+
+			if v := policyAttributes(r.SchemaMap()); len(v) > 0 {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validatePolicyDocuments(v),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -774,6 +894,12 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				interceptors = append(interceptors, newIdentityInterceptor(&resource.Identity))
 			}
 
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Finally,
+				why:         Create | Update | Delete,
+				interceptor: reportWaitWarnings(),
+			})
+
 			if resource.Import.CustomImport {
 				if r.Importer == nil || r.Importer.StateContext == nil {
 					errs = append(errs, fmt.Errorf("resource type %s: uses CustomImport but does not define an import function", typeName))
@@ -811,6 +937,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					ctx = retry.NewWarningsContext(ctx)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -1158,6 +1285,101 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+// tagsRuleSchema returns the schema for a default_tags or ignore_tags rule configuration block,
+// adding the resource selector attributes to the specified attributes.
+func tagsRuleSchema(description string, attributes map[string]*schema.Schema) *schema.Schema {
+	attributes["exclude_resource_types"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Resource type name patterns, such as `aws_ec2_*`, the rule does not apply to. Takes precedence over include_resource_types.",
+	}
+	attributes["include_resource_types"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Resource type name patterns, such as `aws_ec2_*`, the rule applies to. When unset, the rule applies to all resource types.",
+	}
+	attributes["services"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Service package names, such as `ec2`, the rule applies to. When unset, the rule applies to all services.",
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: attributes,
+		},
+	}
+}
+
+func expandResourceSelector(tfMap map[string]any) tftags.ResourceSelector {
+	var selector tftags.ResourceSelector
+
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		selector.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		selector.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["services"].(*schema.Set); ok && v.Len() > 0 {
+		selector.Services = flex.ExpandStringValueSet(v)
+	}
+
+	return selector
+}
+
+func expandDefaultTagsRules(ctx context.Context, tfList []any) []tftags.DefaultTagsRule {
+	var rules []tftags.DefaultTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rule := tftags.DefaultTagsRule{
+			ResourceSelector: expandResourceSelector(tfMap),
+		}
+		if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+			rule.Tags = tftags.New(ctx, v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandIgnoreTagsRules(ctx context.Context, tfList []any) []tftags.IgnoreTagsRule {
+	var rules []tftags.IgnoreTagsRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		rule := tftags.IgnoreTagsRule{
+			ResourceSelector: expandResourceSelector(tfMap),
+		}
+		if v, ok := tfMap["keys"].(*schema.Set); ok && v.Len() > 0 {
+			rule.Keys = tftags.New(ctx, v.List())
+		}
+		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+			rule.KeyPrefixes = tftags.New(ctx, v.List())
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1171,9 +1393,15 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
+	var rules []tftags.DefaultTagsRule
+	if v, ok := tfMap["rule"].([]any); ok {
+		rules = expandDefaultTagsRules(ctx, v)
+	}
+
+	if len(tags) > 0 || len(rules) > 0 {
 		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+			Tags:  tftags.New(ctx, tags),
+			Rules: rules,
 		}
 	}
 
@@ -1182,6 +1410,7 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
+	var rules []tftags.IgnoreTagsRule
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -1190,6 +1419,9 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["rule"].([]any); ok {
+			rules = expandIgnoreTagsRules(ctx, v)
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -1210,14 +1442,16 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes or rules are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(rules) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		Rules: rules,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1228,6 +1462,26 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
+func expandRateLimits(tfList []any) []ratelimit.Config {
+	var apiObjects []ratelimit.Config
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, ratelimit.Config{
+			Service:           tfMap["service"].(string),
+			Operation:         tfMap["operation"].(string),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			MaxConcurrency:    tfMap["max_concurrency"].(int),
+		})
+	}
+
+	return apiObjects
+}
+
 func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
@@ -1249,6 +1503,41 @@ func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
 	return append(diags, errs.NewInvalidValueAttributeError(path, `Must be one of "error", "warning", or "disabled"`))
 }
 
+func expandPolicyLintConfig(path cty.Path, severity string) (*policylint.Config, diag.Diagnostics) {
+	envSeverity := os.Getenv(policylint.EnvVar)
+	switch {
+	case severity == "disabled":
+		return nil, nil
+	case severity != "":
+		return &policylint.Config{Severity: severity}, validatePolicyLintSeverity(path, severity)
+	case envSeverity == "" || envSeverity == "disabled":
+		return nil, nil
+	default:
+		return &policylint.Config{Severity: envSeverity}, validatePolicyLintSeverityEnvVar(envSeverity)
+	}
+}
+
+func validatePolicyLintSeverity(path cty.Path, s string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch s {
+	case "error", "warning", "disabled":
+		return diags
+	}
+	return append(diags, errs.NewInvalidValueAttributeError(path, `Must be one of "error", "warning", or "disabled"`))
+}
+
+func validatePolicyLintSeverityEnvVar(s string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	switch s {
+	case "error", "warning", "disabled":
+		return diags
+	}
+	return append(diags, errs.NewErrorDiagnostic(
+		summaryInvalidEnvironmentVariableValue,
+		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, policylint.EnvVar),
+	))
+}
+
 const (
 	summaryInvalidEnvironmentVariableValue = "Invalid environment variable value"
 )
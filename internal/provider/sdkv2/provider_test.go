@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify/policylint"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -425,6 +426,86 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandPolicyLintConfig(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		severity       string
+		envvars        map[string]string
+		expectedConfig *policylint.Config
+		expectError    bool
+	}{
+		"unset": {
+			expectedConfig: nil,
+		},
+		"config": {
+			severity:       "warning",
+			expectedConfig: &policylint.Config{Severity: "warning"},
+		},
+		"config disabled": {
+			severity:       "disabled",
+			expectedConfig: nil,
+		},
+		"config invalid": {
+			severity:       "info",
+			expectedConfig: &policylint.Config{Severity: "info"},
+			expectError:    true,
+		},
+		"envvar": {
+			envvars: map[string]string{
+				policylint.EnvVar: "error",
+			},
+			expectedConfig: &policylint.Config{Severity: "error"},
+		},
+		"envvar disabled": {
+			envvars: map[string]string{
+				policylint.EnvVar: "disabled",
+			},
+			expectedConfig: nil,
+		},
+		"envvar invalid": {
+			envvars: map[string]string{
+				policylint.EnvVar: "info",
+			},
+			expectedConfig: &policylint.Config{Severity: "info"},
+			expectError:    true,
+		},
+		"config overrides envvar": {
+			severity: "warning",
+			envvars: map[string]string{
+				policylint.EnvVar: "error",
+			},
+			expectedConfig: &policylint.Config{Severity: "warning"},
+		},
+		"config disabled overrides envvar": {
+			severity: "disabled",
+			envvars: map[string]string{
+				policylint.EnvVar: "error",
+			},
+			expectedConfig: nil,
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			results, diags := expandPolicyLintConfig(cty.GetAttrPath("policy_lint"), testcase.severity)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Errorf("expected error %t, got %t: %v", want, got, diags)
+			}
+
+			if diff := cmp.Diff(testcase.expectedConfig, results); diff != "" {
+				t.Errorf("Unexpected policy_lint diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -5,8 +5,10 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"unique"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -109,6 +111,16 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 			if err := d.Set(names.AttrTagsAll, tags.Map()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTagsAll, err)
 			}
+
+			// Optionally report any tags that were silently dropped on creation.
+			// Tags can't be verified if the service package has no ListTags method.
+			if config := c.TagsReportingConfig(ctx); why == Create && config != nil && config.VerifyOnCreate && tagsInContext.TagsOut.IsSome() {
+				if notApplied := tagsInContext.TagsIn.UnwrapOrDefault().NotApplied(tagsInContext.TagsOut.UnwrapOrDefault()); len(notApplied) > 0 {
+					keys := notApplied.Keys()
+					slices.Sort(keys)
+					diags = sdkdiag.AppendWarningf(diags, "The following tags were sent when creating %s %s (%s) but were not applied: %s", serviceName, resourceName, d.Id(), strings.Join(keys, ", "))
+				}
+			}
 		}
 	case Finally:
 		switch why {
@@ -300,6 +312,42 @@ func setTagsAll() customizeDiffInterceptor {
 	})
 }
 
+// reportTagsProvenance reports the source of every key in tags_all as a plan warning when tags_all is planned to change.
+func reportTagsProvenance() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.TagsReportingConfig(ctx)
+		if config == nil || !config.Provenance {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				if !d.GetRawState().IsNull() && !d.HasChange(names.AttrTagsAll) {
+					return nil
+				}
+
+				if provenance := tftags.Provenance(c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))); len(provenance) > 0 {
+					if !addPlanWarning(ctx, names.AttrTagsAll, "Tags Provenance", provenance.String()) {
+						tflog.Warn(ctx, "Tags Provenance", map[string]any{
+							"detail": provenance.String(),
+						})
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
 func validateRequiredTags() customizeDiffInterceptor {
 	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
 		c := opts.c
@@ -313,8 +361,9 @@ func validateRequiredTags() customizeDiffInterceptor {
 		if policy == nil {
 			return nil
 		}
-		reqTags, ok := policy.RequiredTags[typeName]
-		if !ok {
+		reqTags, hasReqTags := policy.RequiredTags[typeName]
+		_, hasRules := policy.EnforcedTagRules[typeName]
+		if !hasReqTags && !hasRules {
 			return nil
 		}
 
@@ -335,27 +384,47 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
-					return nil
+
+				type finding struct {
+					summary, detail string
+				}
+				var findings []finding
+
+				if !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					findings = append(findings, finding{
+						summary: "Missing Required Tags",
+						detail:  fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing),
+					})
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
+				for _, v := range policy.Violations(typeName, allTags) {
+					findings = append(findings, finding{
+						summary: "Noncompliant Tag",
+						detail:  fmt.Sprintf("tags[%q]: %s", v.Key, v.Detail),
 					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
 				}
+
+				var errs []error
+				for _, f := range findings {
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are reported in the plan, or logged outside of a plan
+						if !addPlanWarning(ctx, names.AttrTags, f.summary, f.detail) {
+							tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+								"summary": f.summary,
+								"detail":  f.detail,
+							})
+						}
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", f.summary, f.detail))
+					}
+				}
+
+				return errors.Join(errs...)
 			}
 		}
 
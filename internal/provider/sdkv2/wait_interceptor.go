@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+// reportWaitWarnings reports long-running waits for state changes as warnings.
+func reportWaitWarnings() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch when := opts.when; when {
+		case Finally:
+			for _, warning := range retry.WarningsFromContext(ctx) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  warning.Summary,
+					Detail:   warning.Detail,
+				})
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Config is a client-side limit on requests to an AWS service, or to a single API operation of a service.
+type Config struct {
+	// Service is the service package name, e.g. "route53".
+	Service string
+
+	// Operation is the optional API operation name, e.g. "ChangeResourceRecordSets".
+	// When empty, the limit applies to all of the service's operations together.
+	Operation string
+
+	// RequestsPerSecond is the maximum sustained request rate. Zero means unlimited.
+	RequestsPerSecond float64
+
+	// MaxConcurrency is the maximum number of requests in flight. Zero means unlimited.
+	MaxConcurrency int
+}
+
+// Limiter limits the rate and concurrency of requests.
+type Limiter struct {
+	interval time.Duration
+	lock     sync.Mutex
+	next     time.Time
+	sem      chan struct{}
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second, at most maxConcurrency at a time.
+func NewLimiter(requestsPerSecond float64, maxConcurrency int) *Limiter {
+	l := &Limiter{}
+
+	if requestsPerSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxConcurrency > 0 {
+		l.sem = make(chan struct{}, maxConcurrency)
+	}
+
+	return l
+}
+
+// Acquire blocks until a request is allowed, or the context is done.
+// On success the returned function must be called once the request has completed.
+func (l *Limiter) Acquire(ctx context.Context) (func(), error) {
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.sem != nil {
+			<-l.sem
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// wait reserves the next available request slot and blocks until it's reached.
+func (l *Limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.lock.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.lock.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Limiters are the configured Limiters, by service package name and API operation name.
+type Limiters struct {
+	services   map[string]*Limiter
+	operations map[string]map[string]*Limiter
+}
+
+// New returns the Limiters for the specified configuration.
+// A nil value is returned if there is no configuration.
+func New(configs []Config) *Limiters {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	l := &Limiters{
+		services:   make(map[string]*Limiter),
+		operations: make(map[string]map[string]*Limiter),
+	}
+
+	for _, config := range configs {
+		limiter := NewLimiter(config.RequestsPerSecond, config.MaxConcurrency)
+
+		if config.Operation == "" {
+			l.services[config.Service] = limiter
+			continue
+		}
+
+		if _, ok := l.operations[config.Service]; !ok {
+			l.operations[config.Service] = make(map[string]*Limiter)
+		}
+		l.operations[config.Service][config.Operation] = limiter
+	}
+
+	return l
+}
+
+// APIOption returns an AWS SDK for Go v2 API client option that installs
+// middleware enforcing the limits for the specified service package.
+// A nil value is returned if the service package has no limits.
+func (l *Limiters) APIOption(servicePackageName string) func(*middleware.Stack) error {
+	if l == nil {
+		return nil
+	}
+
+	service, operations := l.services[servicePackageName], l.operations[servicePackageName]
+	if service == nil && len(operations) == 0 {
+		return nil
+	}
+
+	mw := middleware.FinalizeMiddlewareFunc("RateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		// Operation limits are acquired before the service limit so that a throttled operation doesn't hold service capacity.
+		for _, limiter := range []*Limiter{operations[awsmiddleware.GetOperationName(ctx)], service} {
+			if limiter == nil {
+				continue
+			}
+
+			release, err := limiter.Acquire(ctx)
+			if err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for %s rate limit: %w", servicePackageName, err)
+			}
+			defer release()
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	return func(stack *middleware.Stack) error {
+		// Limit each attempt, including retries.
+		if _, ok := stack.Finalize.Get("Retry"); ok {
+			return stack.Finalize.Insert(mw, "Retry", middleware.After)
+		}
+
+		return stack.Finalize.Add(mw, middleware.Before)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestLimiterRequestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := NewLimiter(50, 0)
+
+	start := time.Now()
+	for range 5 {
+		release, err := l.Acquire(ctx)
+		if err != nil {
+			t.Fatalf("Acquire: %s", err)
+		}
+		release()
+	}
+
+	// The first request is immediate, the remaining 4 are spaced 20ms apart.
+	if elapsed, want := time.Since(start), 80*time.Millisecond; elapsed < want {
+		t.Errorf("elapsed = %s, want at least %s", elapsed, want)
+	}
+}
+
+func TestLimiterMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := NewLimiter(0, 2)
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			release, err := l.Acquire(ctx)
+			if err != nil {
+				t.Errorf("Acquire: %s", err)
+				return
+			}
+			defer release()
+
+			n := inFlight.Add(1)
+			for {
+				if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		})
+	}
+	wg.Wait()
+
+	if got, want := maxInFlight.Load(), int32(2); got > want {
+		t.Errorf("max in flight = %d, want at most %d", got, want)
+	}
+}
+
+func TestLimiterContextDone(t *testing.T) {
+	t.Parallel()
+
+	l := NewLimiter(0, 1)
+
+	release, err := l.Acquire(t.Context())
+	if err != nil {
+		t.Fatalf("Acquire: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.Acquire(ctx); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestLimitersAPIOption(t *testing.T) {
+	t.Parallel()
+
+	var l *Limiters
+	if l.APIOption("route53") != nil {
+		t.Error("nil Limiters: expected no API option")
+	}
+
+	l = New([]Config{
+		{Service: "route53", Operation: "ChangeResourceRecordSets", MaxConcurrency: 1},
+		{Service: "wafv2", RequestsPerSecond: 10},
+	})
+
+	if l.APIOption("s3") != nil {
+		t.Error("s3: expected no API option")
+	}
+
+	for _, testCase := range []struct {
+		operation      string
+		maxConcurrency int32 // 0 means unlimited
+	}{
+		{operation: "ChangeResourceRecordSets", maxConcurrency: 1},
+		{operation: "ListResourceRecordSets"},
+	} {
+		t.Run(testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			apiOption := l.APIOption("route53")
+			if apiOption == nil {
+				t.Fatal("route53: expected API option")
+			}
+
+			var inFlight, maxInFlight atomic.Int32
+			terminal := middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+				n := inFlight.Add(1)
+				for {
+					if m := maxInFlight.Load(); n <= m || maxInFlight.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				inFlight.Add(-1)
+
+				return nil, middleware.Metadata{}, nil
+			})
+
+			var wg sync.WaitGroup
+			for range 4 {
+				wg.Go(func() {
+					// As with API clients, each operation invocation has its own stack.
+					stack := middleware.NewStack(testCase.operation, func() any { return nil })
+					if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{OperationName: testCase.operation}, middleware.Before); err != nil {
+						t.Errorf("adding metadata middleware: %s", err)
+						return
+					}
+					if err := apiOption(stack); err != nil {
+						t.Errorf("applying API option: %s", err)
+						return
+					}
+
+					if _, _, err := middleware.DecorateHandler(terminal, stack).Handle(t.Context(), nil); err != nil {
+						t.Errorf("Handle: %s", err)
+					}
+				})
+			}
+			wg.Wait()
+
+			if got, want := maxInFlight.Load(), testCase.maxConcurrency; want > 0 && got > want {
+				t.Errorf("max in flight = %d, want at most %d", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+const (
+	// DefaultProgressInterval is how often the progress of a state change wait is reported by default.
+	DefaultProgressInterval = 1 * time.Minute
+
+	// DefaultWarningThreshold is the elapsed time after which a state change wait is reported as a warning by default.
+	DefaultWarningThreshold = 20 * time.Minute
+
+	// maxHistory is the number of previous wait durations used to estimate the remaining time of a wait.
+	maxHistory = 10
+)
+
+// WaitProgress describes the progress of a state change wait.
+type WaitProgress struct {
+	Name               string        // Name of the wait
+	State              string        // Latest state
+	Refreshes          int           // Number of times the state has been refreshed
+	Elapsed            time.Duration // Time since the wait started
+	EstimatedRemaining time.Duration // Estimated time until the wait completes, from the durations of previous waits with the same name. Zero if there are no previous waits
+	Timeout            time.Duration // The amount of time to wait before timeout
+}
+
+// ProgressFunc is called periodically while waiting for a state change.
+// If it returns an error the wait is canceled and the error is returned.
+type ProgressFunc func(context.Context, WaitProgress) error
+
+// waitHistory records the durations of completed waits by name.
+// So that estimates are available to later provider runs, the history can be persisted to a file.
+var waitHistory = struct {
+	sync.Mutex
+	loaded    bool
+	durations map[string][]time.Duration
+}{}
+
+// waitHistoryPath returns the path of the file that wait durations are persisted to, or "" if they are only kept in memory.
+func waitHistoryPath() string {
+	return os.Getenv(envvar.WaitHistoryFile)
+}
+
+// readWaitHistory returns the wait durations persisted to the specified file.
+// A missing or unreadable file is an empty history.
+func readWaitHistory(path string) map[string][]time.Duration {
+	durations := make(map[string][]time.Duration)
+
+	if path == "" {
+		return durations
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return durations
+	}
+
+	if err := json.Unmarshal(b, &durations); err != nil {
+		return make(map[string][]time.Duration)
+	}
+
+	return durations
+}
+
+// writeWaitHistory persists wait durations to the specified file.
+func writeWaitHistory(path string, durations map[string][]time.Duration) error {
+	b, err := json.Marshal(durations)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	// Replace the file atomically as other provider processes may be reading it.
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func recordWaitDuration(ctx context.Context, name string, d time.Duration) {
+	waitHistory.Lock()
+	defer waitHistory.Unlock()
+
+	path := waitHistoryPath()
+	if path != "" || !waitHistory.loaded {
+		// Include waits recorded by other provider processes.
+		waitHistory.durations = readWaitHistory(path)
+		waitHistory.loaded = true
+	}
+
+	durations := append(waitHistory.durations[name], d)
+	if len(durations) > maxHistory {
+		durations = durations[len(durations)-maxHistory:]
+	}
+	waitHistory.durations[name] = durations
+
+	if path != "" {
+		if err := writeWaitHistory(path, waitHistory.durations); err != nil {
+			tflog.Debug(ctx, "Unable to persist wait durations", map[string]any{
+				"path":  path,
+				"error": err.Error(),
+			})
+		}
+	}
+}
+
+// estimatedWaitDuration returns the mean duration of previous waits with the specified name.
+func estimatedWaitDuration(name string) (time.Duration, bool) {
+	waitHistory.Lock()
+	defer waitHistory.Unlock()
+
+	if !waitHistory.loaded {
+		waitHistory.durations = readWaitHistory(waitHistoryPath())
+		waitHistory.loaded = true
+	}
+
+	durations := waitHistory.durations[name]
+	if len(durations) == 0 {
+		return 0, false
+	}
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	return total / time.Duration(len(durations)), true
+}
+
+// warningThreshold returns the elapsed time after which a wait is reported as a warning.
+// Zero disables warnings.
+func warningThreshold(ctx context.Context) time.Duration {
+	v := os.Getenv(envvar.WaitWarningThreshold)
+	if v == "" {
+		return DefaultWarningThreshold
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		tflog.Warn(ctx, "Ignoring invalid wait warning threshold", map[string]any{
+			"env_var": envvar.WaitWarningThreshold,
+			"value":   v,
+		})
+		return DefaultWarningThreshold
+	}
+
+	return d
+}
+
+// CallerName returns the package-qualified name of the function skip frames above the caller, e.g. "rds.waitDBClusterCreated".
+// It is used to name state change waits.
+func CallerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return ""
+	}
+
+	name := f.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+// progressReporter reports the progress of a single state change wait.
+type progressReporter struct {
+	progress         WaitProgress
+	start            time.Time
+	lastReport       time.Time
+	interval         time.Duration
+	threshold        time.Duration
+	updateWarning    func(Warning) // Set once the warning threshold is exceeded
+	progressFunc     ProgressFunc
+	expectedDuration time.Duration
+	target           []string
+}
+
+func newProgressReporter(ctx context.Context, name string, timeout, interval time.Duration, f ProgressFunc, target []string) *progressReporter {
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	r := &progressReporter{
+		progress: WaitProgress{
+			Name:    name,
+			Timeout: timeout,
+		},
+		start:        time.Now(),
+		interval:     interval,
+		threshold:    warningThreshold(ctx),
+		progressFunc: f,
+		target:       target,
+	}
+	r.lastReport = r.start
+	r.expectedDuration, _ = estimatedWaitDuration(name)
+
+	return r
+}
+
+func (r *progressReporter) fields() map[string]any {
+	fields := map[string]any{
+		"wait_name":         r.progress.Name,
+		"wait_state":        r.progress.State,
+		"wait_target_state": r.target,
+		"wait_refreshes":    r.progress.Refreshes,
+		"wait_elapsed":      r.progress.Elapsed.Round(time.Second).String(),
+		"wait_timeout":      r.progress.Timeout.String(),
+	}
+	if r.expectedDuration > 0 {
+		fields["wait_estimated_remaining"] = r.progress.EstimatedRemaining.Round(time.Second).String()
+	}
+
+	return fields
+}
+
+// refreshed is called after each successful refresh of the state being waited on.
+// Progress is reported when the state changes and at most once per interval otherwise.
+func (r *progressReporter) refreshed(ctx context.Context, state string) error {
+	now := time.Now()
+	stateChanged := state != r.progress.State
+
+	r.progress.State = state
+	r.progress.Refreshes++
+	r.progress.Elapsed = now.Sub(r.start)
+	if r.expectedDuration > 0 {
+		r.progress.EstimatedRemaining = max(r.expectedDuration-r.progress.Elapsed, 0)
+	}
+
+	// Warn as soon as the threshold is exceeded, while the wait is still running.
+	if r.threshold > 0 && r.progress.Elapsed > r.threshold && r.updateWarning == nil {
+		tflog.Warn(ctx, "Long-running wait for state change", r.fields())
+		r.updateWarning = addWarning(ctx, r.warning("has been running for"))
+	}
+
+	if !stateChanged && now.Sub(r.lastReport) < r.interval {
+		return nil
+	}
+	r.lastReport = now
+
+	tflog.Info(ctx, "Waiting for state change", r.fields())
+
+	if r.progressFunc != nil {
+		return r.progressFunc(ctx, r.progress)
+	}
+
+	return nil
+}
+
+// done is called when the wait completes.
+// Durations of successful waits are recorded and any warning raised when the threshold was exceeded is updated with the outcome.
+func (r *progressReporter) done(ctx context.Context, err error) {
+	r.progress.Elapsed = time.Since(r.start)
+
+	if err == nil && r.progress.Name != "" {
+		recordWaitDuration(ctx, r.progress.Name, r.progress.Elapsed)
+	}
+
+	if r.threshold > 0 && r.progress.Elapsed > r.threshold {
+		outcome := "completed after"
+		if err != nil {
+			outcome = "failed after"
+		}
+
+		if r.updateWarning == nil {
+			r.updateWarning = addWarning(ctx, r.warning(outcome))
+		} else {
+			r.updateWarning(r.warning(outcome))
+		}
+	}
+}
+
+// warning returns the warning for a wait that has exceeded the warning threshold.
+func (r *progressReporter) warning(status string) Warning {
+	what := "be removed"
+	if len(r.target) > 0 {
+		what = "reach state " + strings.Join(r.target, " or ")
+	}
+
+	return Warning{
+		Summary: "Long-running wait for state change",
+		Detail: fmt.Sprintf("%s, waiting for the resource to %s, %s %s, exceeding the warning threshold of %s. The last state was %q.",
+			r.progress.Name, what, status, r.progress.Elapsed.Round(time.Second), r.threshold, r.progress.State),
+	}
+}
+
+// Warning is a warning raised while waiting for a state change.
+type Warning struct {
+	Summary string
+	Detail  string
+}
+
+type warningsKey struct{}
+
+type warnings struct {
+	sync.Mutex
+	values []*Warning
+}
+
+// NewWarningsContext returns a context that collects warnings raised by state change waits.
+func NewWarningsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, &warnings{})
+}
+
+// WarningsFromContext returns, and clears, the warnings collected in the context.
+func WarningsFromContext(ctx context.Context) []Warning {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return nil
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	var values []Warning
+	for _, v := range w.values {
+		values = append(values, *v)
+	}
+	w.values = nil
+
+	return values
+}
+
+// addWarning adds a warning to those collected in the context.
+// The returned function replaces the warning if it has not yet been returned by WarningsFromContext.
+func addWarning(ctx context.Context, warning Warning) func(Warning) {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return func(Warning) {}
+	}
+
+	w.Lock()
+	defer w.Unlock()
+
+	v := &warning
+	w.values = append(w.values, v)
+
+	return func(warning Warning) {
+		w.Lock()
+		defer w.Unlock()
+
+		*v = warning
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func sequenceStateRefreshFunc(states ...string) StateRefreshFunc {
+	return func(context.Context) (any, string, error) {
+		if len(states) == 0 {
+			return nil, "", errors.New("no more states")
+		}
+
+		state := states[0]
+		states = states[1:]
+
+		return &value{val: state}, state, nil
+	}
+}
+
+func TestWaitForState_progress(t *testing.T) {
+	t.Parallel()
+
+	var got []WaitProgress
+	conf := &StateChangeConf{
+		Pending:          []string{"creating", "backing-up"},
+		Target:           []string{"available"},
+		Refresh:          sequenceStateRefreshFunc("creating", "creating", "backing-up", "available"),
+		Timeout:          10 * time.Second,
+		PollInterval:     time.Millisecond,
+		ProgressInterval: time.Hour,
+		Progress: func(_ context.Context, progress WaitProgress) error {
+			got = append(got, progress)
+			return nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Progress is reported on each state change.
+	var states []string
+	for _, v := range got {
+		states = append(states, v.State)
+	}
+	if want := "creating,backing-up,available"; strings.Join(states, ",") != want {
+		t.Errorf("reported states: got %v, want %s", states, want)
+	}
+
+	if n := len(got); n > 0 {
+		last := got[n-1]
+		if want := "retry.TestWaitForState_progress"; last.Name != want {
+			t.Errorf("Name: got %q, want %q", last.Name, want)
+		}
+		if want := 4; last.Refreshes != want {
+			t.Errorf("Refreshes: got %d, want %d", last.Refreshes, want)
+		}
+		if want := 10 * time.Second; last.Timeout != want {
+			t.Errorf("Timeout: got %s, want %s", last.Timeout, want)
+		}
+	}
+}
+
+func TestWaitForState_progressCancel(t *testing.T) {
+	t.Parallel()
+
+	errCanceled := errors.New("canceled by progress function")
+	conf := &StateChangeConf{
+		Pending:      []string{"running"},
+		Target:       []string{"stopped"},
+		Refresh:      SuccessfulStateRefreshFunc(),
+		Timeout:      10 * time.Second,
+		PollInterval: time.Millisecond,
+		Progress: func(_ context.Context, progress WaitProgress) error {
+			return errCanceled
+		},
+	}
+
+	obj, err := conf.WaitForStateContext(context.Background())
+
+	if !errors.Is(err, errCanceled) {
+		t.Fatalf("expected error %q, got: %v", errCanceled, err)
+	}
+	if obj != nil {
+		t.Errorf("expected nil result, got: %v", obj)
+	}
+}
+
+func TestWaitForState_progressEstimatedRemaining(t *testing.T) {
+	t.Parallel()
+
+	const name = "retry.TestWaitForState_progressEstimatedRemaining"
+	recordWaitDuration(t.Context(), name, 2*time.Hour)
+	recordWaitDuration(t.Context(), name, 4*time.Hour)
+
+	var got WaitProgress
+	conf := &StateChangeConf{
+		Pending:      []string{"creating"},
+		Target:       []string{"available"},
+		Refresh:      sequenceStateRefreshFunc("creating", "available"),
+		Timeout:      10 * time.Second,
+		PollInterval: time.Millisecond,
+		Name:         name,
+		Progress: func(_ context.Context, progress WaitProgress) error {
+			if got.Refreshes == 0 {
+				got = progress
+			}
+			return nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Estimated from the mean of the previous waits (3h).
+	if got.EstimatedRemaining <= 2*time.Hour || got.EstimatedRemaining > 3*time.Hour {
+		t.Errorf("EstimatedRemaining: got %s, want about 3h", got.EstimatedRemaining)
+	}
+
+	if d, ok := estimatedWaitDuration(name); !ok || d >= 3*time.Hour {
+		t.Errorf("expected successful wait to be recorded, got mean duration %s", d)
+	}
+}
+
+func TestWaitHistory_persisted(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	path := filepath.Join(t.TempDir(), "wait-history.json")
+	t.Setenv(envvar.WaitHistoryFile, path)
+
+	const name = "retry.TestWaitHistory_persisted"
+	recordWaitDuration(t.Context(), name, 10*time.Minute)
+	recordWaitDuration(t.Context(), name, 20*time.Minute)
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("expected wait history file: %s", err)
+	}
+
+	// A new provider process starts with no durations in memory.
+	waitHistory.Lock()
+	waitHistory.loaded = false
+	waitHistory.durations = nil
+	waitHistory.Unlock()
+
+	if d, ok := estimatedWaitDuration(name); !ok || d != 15*time.Minute {
+		t.Errorf("estimatedWaitDuration: got %s, %t, want %s", d, ok, 15*time.Minute)
+	}
+}
+
+func TestWaitForState_warnings(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testCases := map[string]struct {
+		threshold    string
+		wantWarnings int
+	}{
+		"exceeded": {
+			threshold:    "1ns",
+			wantWarnings: 1,
+		},
+		"not exceeded": {
+			threshold:    "1h",
+			wantWarnings: 0,
+		},
+		"disabled": {
+			threshold:    "0",
+			wantWarnings: 0,
+		},
+	}
+
+	for name, testCase := range testCases { //nolint:paralleltest // uses t.Setenv
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envvar.WaitWarningThreshold, testCase.threshold)
+
+			ctx := NewWarningsContext(context.Background())
+			conf := &StateChangeConf{
+				Pending:      []string{"modifying"},
+				Target:       []string{"available"},
+				Refresh:      sequenceStateRefreshFunc("modifying", "available"),
+				Timeout:      10 * time.Second,
+				PollInterval: time.Millisecond,
+			}
+
+			if _, err := conf.WaitForStateContext(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			warnings := WarningsFromContext(ctx)
+			if got, want := len(warnings), testCase.wantWarnings; got != want {
+				t.Fatalf("warnings: got %d, want %d", got, want)
+			}
+			if len(warnings) > 0 && !strings.Contains(warnings[0].Detail, "reach state available, completed") {
+				t.Errorf("unexpected warning detail: %s", warnings[0].Detail)
+			}
+
+			if got := WarningsFromContext(ctx); len(got) != 0 {
+				t.Errorf("expected warnings to be cleared, got %d", len(got))
+			}
+		})
+	}
+}
+
+func TestWaitForState_warningWhileRunning(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.WaitWarningThreshold, "1ns")
+
+	var got []Warning
+	ctx := NewWarningsContext(context.Background())
+	conf := &StateChangeConf{
+		Pending:          []string{"modifying"},
+		Target:           []string{"available"},
+		Refresh:          sequenceStateRefreshFunc("modifying", "modifying", "available"),
+		Timeout:          10 * time.Second,
+		PollInterval:     time.Millisecond,
+		ProgressInterval: time.Hour,
+		Progress: func(ctx context.Context, progress WaitProgress) error {
+			if progress.State == "modifying" {
+				got = append(got, WarningsFromContext(ctx)...)
+			}
+			return nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(got), 1; got != want {
+		t.Fatalf("warnings while running: got %d, want %d", got, want)
+	}
+	if !strings.Contains(got[0].Detail, "reach state available, has been running for") {
+		t.Errorf("unexpected warning detail: %s", got[0].Detail)
+	}
+
+	// The warning has already been reported.
+	if got := WarningsFromContext(ctx); len(got) != 0 {
+		t.Errorf("expected no warnings after completion, got %d", len(got))
+	}
+}
@@ -46,6 +46,10 @@ type StateChangeConfOf[T any, S ~string] struct {
 
 	// This is to work around inconsistent APIs
 	ContinuousTargetOccurence int // Number of times the Target state has to occur continuously
+
+	Name             string        // Name of the wait used when reporting progress. Defaults to the name of the function calling WaitForStateContext
+	ProgressInterval time.Duration // How often to report progress. Defaults to DefaultProgressInterval
+	Progress         ProgressFunc  // Called when progress is reported
 }
 
 // StateChangeConf is the specialization used in all code using helper/retry.
@@ -70,7 +74,25 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // When VCR testing is enabled in replay mode, the DelayFunc is overridden to
 // allow interactions to be replayed with no delay between state change refreshes.
+//
+// Progress, including the current state, the elapsed time and the estimated time remaining
+// based on previous waits with the same name, is logged periodically and passed to any
+// Progress function. Waits that take longer than the warning threshold (TF_AWS_WAIT_WARNING_THRESHOLD,
+// DefaultWarningThreshold by default) are added to the warnings collected by NewWarningsContext.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
+	name := conf.Name
+	if name == "" {
+		name = CallerName(1)
+	}
+
+	progress := newProgressReporter(ctx, name, conf.Timeout, conf.ProgressInterval, conf.Progress, tfslices.Strings(conf.Target))
+	t, err := conf.waitForState(ctx, progress)
+	progress.done(ctx, err)
+
+	return t, err
+}
+
+func (conf *StateChangeConfOf[T, S]) waitForState(ctx context.Context, progress *progressReporter) (T, error) {
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
 		conf.NotFoundChecks = 20
@@ -107,6 +129,10 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 			return t, err
 		}
 
+		if err := progress.refreshed(ctx, string(currentState)); err != nil {
+			return inttypes.Zero[T](), err
+		}
+
 		if inttypes.IsZero(t) {
 			// If we're waiting for the absence of a thing, then return.
 			if len(conf.Target) == 0 {
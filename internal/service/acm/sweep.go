@@ -4,20 +4,20 @@
 package acm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &sweep.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -41,8 +41,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -61,6 +60,7 @@ func sweepCertificates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ACM Certificate sweep for %s: %s", region, err)
 			return nil
 		}
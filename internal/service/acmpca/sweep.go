@@ -4,26 +4,25 @@
 package acmpca
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/acmpca/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &sweep.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    sweepCertificateAuthorities,
 	})
 }
 
-func sweepCertificateAuthorities(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertificateAuthorities(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -37,6 +36,7 @@ func sweepCertificateAuthorities(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ACM PCA Certificate Authority sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,25 +4,24 @@
 package amplify
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/amplify"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_amplify_app", &sweep.Sweeper{
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
 }
 
-func sweepApps(region string) error {
-	ctx := sweep.Context(region)
+func sweepApps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -36,6 +35,7 @@ func sweepApps(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Amplify App sweep for %s: %s", region, err)
 			return nil
 		}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -24,27 +23,27 @@ func RegisterSweepers() {
 		"aws_api_gateway_rest_api",
 	)
 
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &sweep.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &sweep.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_client_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_client_certificate", &sweep.Sweeper{
 		Name: "aws_api_gateway_client_certificate",
 		F:    sweepClientCertificates,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_usage_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_usage_plan", &sweep.Sweeper{
 		Name: "aws_api_gateway_usage_plan",
 		F:    sweepUsagePlans,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_api_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_api_key", &sweep.Sweeper{
 		Name: "aws_api_gateway_api_key",
 		F:    sweepAPIKeys,
 		Dependencies: []string{
@@ -52,7 +51,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_domain_name", &sweep.Sweeper{
 		Name: "aws_api_gateway_domain_name",
 		F:    sweepDomainNames,
 	})
@@ -66,8 +65,7 @@ func sweepAccounts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 	}, nil
 }
 
-func sweepRestAPIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -81,6 +79,7 @@ func sweepRestAPIs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, err)
 			return nil
 		}
@@ -107,8 +106,7 @@ func sweepRestAPIs(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -122,6 +120,7 @@ func sweepVPCLinks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway VPC Link sweep for %s: %s", region, err)
 			return nil
 		}
@@ -155,8 +154,7 @@ func sweepVPCLinks(region string) error {
 	return nil
 }
 
-func sweepClientCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -170,6 +168,7 @@ func sweepClientCertificates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway Client Certificate sweep for %s: %s", region, err)
 			return nil
 		}
@@ -196,8 +195,7 @@ func sweepClientCertificates(region string) error {
 	return nil
 }
 
-func sweepUsagePlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsagePlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -211,6 +209,7 @@ func sweepUsagePlans(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway Usage Plan sweep for %s: %s", region, err)
 			return nil
 		}
@@ -238,8 +237,7 @@ func sweepUsagePlans(region string) error {
 	return nil
 }
 
-func sweepAPIKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -253,6 +251,7 @@ func sweepAPIKeys(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway API Key sweep for %s: %s", region, err)
 			return nil
 		}
@@ -279,8 +278,7 @@ func sweepAPIKeys(region string) error {
 	return nil
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -294,6 +292,7 @@ func sweepDomainNames(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping API Gateway Domain Name sweep for %s: %s", region, err)
 			return nil
 		}
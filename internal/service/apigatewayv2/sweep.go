@@ -4,20 +4,20 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -25,12 +25,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_api_mapping", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api_mapping", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_api_mapping",
 		F:    sweepAPIMappings,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 		Dependencies: []string{
@@ -38,14 +38,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &sweep.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
 }
 
-func sweepAPIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -71,6 +70,7 @@ func sweepAPIs(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
 		return nil
 	}
@@ -88,8 +88,7 @@ func sweepAPIs(region string) error {
 	return nil
 }
 
-func sweepAPIMappings(region string) error {
-	ctx := sweep.Context(region)
+func sweepAPIMappings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -140,6 +139,7 @@ func sweepAPIMappings(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping API Gateway v2 API Mapping sweep for %s: %s", region, err)
 		return nil
 	}
@@ -157,8 +157,7 @@ func sweepAPIMappings(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -184,6 +183,7 @@ func sweepDomainNames(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping API Gateway v2 Domain Name sweep for %s: %s", region, err)
 		return nil
 	}
@@ -201,8 +201,7 @@ func sweepDomainNames(region string) error {
 	return nil
 }
 
-func sweepVPCLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -228,6 +227,7 @@ func sweepVPCLinks(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
 		return nil
 	}
@@ -4,19 +4,19 @@
 package apprunner
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apprunner"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_auto_scaling_configuration_version", &sweep.Sweeper{
 		Name: "aws_apprunner_auto_scaling_configuration_version",
 		F:    sweepAutoScalingConfigurationVersions,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_connection", &sweep.Sweeper{
 		Name: "aws_apprunner_connection",
 		F:    sweepConnections,
 		Dependencies: []string{
@@ -32,14 +32,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apprunner_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apprunner_service", &sweep.Sweeper{
 		Name: "aws_apprunner_service",
 		F:    sweepServices,
 	})
 }
 
-func sweepAutoScalingConfigurationVersions(region string) error {
-	ctx := sweep.Context(region)
+func sweepAutoScalingConfigurationVersions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -53,6 +52,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 		output, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping App Runner AutoScaling Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -88,8 +88,7 @@ func sweepAutoScalingConfigurationVersions(region string) error {
 	return nil
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -103,6 +102,7 @@ func sweepConnections(region string) error {
 		output, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping App Runner Connection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -130,8 +130,7 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -145,6 +144,7 @@ func sweepServices(region string) error {
 		output, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping App Runner Service sweep for %s: %s", region, err)
 			return nil
 		}
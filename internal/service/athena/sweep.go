@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -21,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_athena_data_catalog", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_data_catalog", &sweep.Sweeper{
 		Name: "aws_athena_data_catalog",
 		F:    sweepDataCatalogs,
 		Dependencies: []string{
@@ -31,7 +30,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_athena_database", sweepDatabases)
 
-	resource.AddTestSweepers("aws_athena_workgroup", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_athena_workgroup", &sweep.Sweeper{
 		Name: "aws_athena_workgroup",
 		F:    sweepWorkGroups,
 		Dependencies: []string{
@@ -73,8 +72,7 @@ func sweepCapacityReservations(ctx context.Context, client *conns.AWSClient) ([]
 	return sweepResources, nil
 }
 
-func sweepDataCatalogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepDataCatalogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -88,6 +86,7 @@ func sweepDataCatalogs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Athena Data Catalog sweep for %s: %s", region, err)
 			return nil
 		}
@@ -173,8 +172,7 @@ func sweepDatabases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweep
 	return sweepResources, nil
 }
 
-func sweepWorkGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -188,6 +186,7 @@ func sweepWorkGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Athena WorkGroup sweep for %s: %s", region, err)
 			return nil
 		}
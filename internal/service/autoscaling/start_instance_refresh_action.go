@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// startInstanceRefreshPollInterval defines polling cadence for the start instance refresh action.
+	startInstanceRefreshPollInterval = 30 * time.Second
+)
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoRollback          types.Bool                       `tfsdk:"auto_rollback"`
+	AutoScalingGroupName  types.String                     `tfsdk:"autoscaling_group_name"`
+	CheckpointDelay       types.Int64                      `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages fwtypes.ListValueOf[types.Int64] `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup        types.Int64                      `tfsdk:"instance_warmup"`
+	MinHealthyPercentage  types.Int64                      `tfsdk:"min_healthy_percentage"`
+	SkipMatching          types.Bool                       `tfsdk:"skip_matching"`
+	Timeout               types.Int64                      `tfsdk:"timeout"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete. Fails if the instance refresh fails, is cancelled, or is rolled back.",
+		Attributes: map[string]schema.Attribute{
+			"auto_rollback": schema.BoolAttribute{
+				Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails",
+				Optional:    true,
+			},
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "Name of the Auto Scaling group to refresh",
+				Required:    true,
+			},
+			"checkpoint_delay": schema.Int64Attribute{
+				Description: "Number of seconds to wait after a checkpoint before continuing the instance refresh",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 172800),
+				},
+			},
+			"checkpoint_percentages": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfInt64Type,
+				Description: "Ascending list of percentages of instances to replace at which the instance refresh pauses. The last value must be 100",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(1, 100)),
+				},
+			},
+			"instance_warmup": schema.Int64Attribute{
+				Description: "Number of seconds until a newly launched instance is configured and ready to use. Defaults to the group's health check grace period",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_healthy_percentage": schema.Int64Attribute{
+				Description: "Percentage of the desired capacity that must remain healthy during the instance refresh (default: 90)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"skip_matching": schema.BoolAttribute{
+				Description: "Whether to skip replacing instances that already match the desired configuration",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := config.AutoScalingGroupName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Auto Scaling start instance refresh action", map[string]any{
+		"autoscaling_group_name": name,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	group, err := findGroupByName(ctx, conn, name)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Auto Scaling Group Not Found",
+			fmt.Sprintf("Auto Scaling group %s was not found", name),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Auto Scaling Group",
+			fmt.Sprintf("Could not describe Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	preferences := &awstypes.RefreshPreferences{
+		AutoRollback:         fwflex.BoolFromFramework(ctx, config.AutoRollback),
+		CheckpointDelay:      fwflex.Int32FromFrameworkInt64(ctx, config.CheckpointDelay),
+		InstanceWarmup:       fwflex.Int32FromFrameworkInt64(ctx, config.InstanceWarmup),
+		MinHealthyPercentage: fwflex.Int32FromFrameworkInt64(ctx, config.MinHealthyPercentage),
+		SkipMatching:         fwflex.BoolFromFramework(ctx, config.SkipMatching),
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config.CheckpointPercentages, &preferences.CheckpointPercentages)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Preferences:          preferences,
+		Strategy:             awstypes.RefreshStrategyRolling,
+	}
+
+	// "The AutoRollback parameter cannot be set to true when the DesiredConfiguration parameter is empty".
+	if aws.ToBool(preferences.AutoRollback) {
+		input.DesiredConfiguration = &awstypes.DesiredConfiguration{
+			LaunchTemplate:       group.LaunchTemplate,
+			MixedInstancesPolicy: group.MixedInstancesPolicy,
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting instance refresh of Auto Scaling group %s...", name),
+	})
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if errs.IsA[*awstypes.InstanceRefreshInProgressFault](err) {
+		resp.Diagnostics.AddError(
+			"Instance Refresh In Progress",
+			fmt.Sprintf("An instance refresh of Auto Scaling group %s is already in progress", name),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Instance Refresh",
+			fmt.Sprintf("Could not start instance refresh of Auto Scaling group %s: %s", name, err),
+		)
+		return
+	}
+
+	refreshID := aws.ToString(output.InstanceRefreshId)
+
+	tflog.Info(ctx, "Auto Scaling group instance refresh started", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    refreshID,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s of Auto Scaling group %s started, waiting for completion...", refreshID, name),
+	})
+
+	var lastStatus actionwait.Status
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{refreshID},
+		}
+		output, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, fmt.Errorf("describing instance refresh: %w", err)
+		}
+
+		status := actionwait.Status(output.Status)
+
+		// Report every status change immediately.
+		if status != lastStatus && lastStatus != "" {
+			resp.SendProgress(action.InvokeProgressEvent{Message: instanceRefreshProgressMessage(name, output)})
+		}
+		lastStatus = status
+
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: status, Value: output}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startInstanceRefreshPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.InstanceRefreshStatusSuccessful)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if v, ok := fr.Value.(*awstypes.InstanceRefresh); ok {
+				resp.SendProgress(action.InvokeProgressEvent{Message: instanceRefreshProgressMessage(name, v)})
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s did not complete within %s: %s", refreshID, name, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			var reason string
+			if fr.Value != nil {
+				reason = aws.ToString(fr.Value.StatusReason)
+			}
+
+			switch awstypes.InstanceRefreshStatus(failureErr.Status) {
+			case awstypes.InstanceRefreshStatusRollbackSuccessful, awstypes.InstanceRefreshStatusRollbackFailed:
+				resp.Diagnostics.AddError(
+					"Instance Refresh Rolled Back",
+					fmt.Sprintf("Instance refresh %s of Auto Scaling group %s was rolled back (%s). %s", refreshID, name, failureErr.Status, reason),
+				)
+			default:
+				resp.Diagnostics.AddError(
+					"Instance Refresh Failed",
+					fmt.Sprintf("Instance refresh %s of Auto Scaling group %s completed with status %s. %s", refreshID, name, failureErr.Status, reason),
+				)
+			}
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Instance Refresh Status",
+				fmt.Sprintf("Instance refresh %s of Auto Scaling group %s entered unexpected status: %s", refreshID, name, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Instance Refresh",
+				fmt.Sprintf("Error while waiting for instance refresh %s of Auto Scaling group %s: %s", refreshID, name, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s of Auto Scaling group %s completed successfully", refreshID, name),
+	})
+
+	tflog.Info(ctx, "Auto Scaling start instance refresh action completed successfully", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    refreshID,
+	})
+}
+
+func instanceRefreshProgressMessage(name string, v *awstypes.InstanceRefresh) string {
+	message := fmt.Sprintf("Instance refresh %s of Auto Scaling group %s is %s: %d%% complete, %d instances remaining",
+		aws.ToString(v.InstanceRefreshId), name, v.Status, aws.ToInt32(v.PercentageComplete), aws.ToInt32(v.InstancesToUpdate))
+	if reason := aws.ToString(v.StatusReason); reason != "" {
+		message += " (" + reason + ")"
+	}
+
+	return message
+}
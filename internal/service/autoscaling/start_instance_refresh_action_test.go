@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, t, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, t, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, t, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+					testAccCheckInstanceRefreshPreferences(ctx, t, &group, 0, &awstypes.RefreshPreferences{
+						AutoRollback:          aws.Bool(true),
+						CheckpointDelay:       aws.Int32(60),
+						CheckpointPercentages: []int32{50, 100},
+						InstanceWarmup:        aws.Int32(30),
+						MinHealthyPercentage:  aws.Int32(0),
+						SkipMatching:          aws.Bool(true),
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckInstanceRefreshPreferences checks the preferences of the instance refresh at the specified index.
+// Only the preferences set in expected are compared.
+func testAccCheckInstanceRefreshPreferences(ctx context.Context, t *testing.T, v *awstypes.AutoScalingGroup, index int, expected *awstypes.RefreshPreferences) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).AutoScalingClient(ctx)
+
+		output, err := tfautoscaling.FindInstanceRefreshes(ctx, conn, &autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: v.AutoScalingGroupName,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got <= index {
+			return fmt.Errorf("Expected at least %d Instance Refreshes, got %d", index+1, got)
+		}
+
+		preferences := output[index].Preferences
+		if preferences == nil {
+			return fmt.Errorf("Instance Refresh at index %d has no preferences", index)
+		}
+
+		if expected.AutoRollback != nil && aws.ToBool(preferences.AutoRollback) != aws.ToBool(expected.AutoRollback) {
+			return fmt.Errorf("Expected Instance Refresh AutoRollback to be %t, got %t", aws.ToBool(expected.AutoRollback), aws.ToBool(preferences.AutoRollback))
+		}
+		if expected.CheckpointDelay != nil && aws.ToInt32(preferences.CheckpointDelay) != aws.ToInt32(expected.CheckpointDelay) {
+			return fmt.Errorf("Expected Instance Refresh CheckpointDelay to be %d, got %d", aws.ToInt32(expected.CheckpointDelay), aws.ToInt32(preferences.CheckpointDelay))
+		}
+		if expected.CheckpointPercentages != nil && !slices.Equal(preferences.CheckpointPercentages, expected.CheckpointPercentages) {
+			return fmt.Errorf("Expected Instance Refresh CheckpointPercentages to be %v, got %v", expected.CheckpointPercentages, preferences.CheckpointPercentages)
+		}
+		if expected.InstanceWarmup != nil && aws.ToInt32(preferences.InstanceWarmup) != aws.ToInt32(expected.InstanceWarmup) {
+			return fmt.Errorf("Expected Instance Refresh InstanceWarmup to be %d, got %d", aws.ToInt32(expected.InstanceWarmup), aws.ToInt32(preferences.InstanceWarmup))
+		}
+		if expected.MinHealthyPercentage != nil && aws.ToInt32(preferences.MinHealthyPercentage) != aws.ToInt32(expected.MinHealthyPercentage) {
+			return fmt.Errorf("Expected Instance Refresh MinHealthyPercentage to be %d, got %d", aws.ToInt32(expected.MinHealthyPercentage), aws.ToInt32(preferences.MinHealthyPercentage))
+		}
+		if expected.SkipMatching != nil && aws.ToBool(preferences.SkipMatching) != aws.ToBool(expected.SkipMatching) {
+			return fmt.Errorf("Expected Instance Refresh SkipMatching to be %t, got %t", aws.ToBool(expected.SkipMatching), aws.ToBool(preferences.SkipMatching))
+		}
+
+		return nil
+	}
+}
+
+func testAccStartInstanceRefreshActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, "t2.micro"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName))
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    min_healthy_percentage = 0
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    auto_rollback          = true
+    checkpoint_delay       = 60
+    checkpoint_percentages = [50, 100]
+    instance_warmup        = 30
+    min_healthy_percentage = 0
+    skip_matching          = true
+    timeout                = 3600
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
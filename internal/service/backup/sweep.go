@@ -4,13 +4,13 @@
 package backup
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -18,12 +18,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_backup_framework", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_framework", &sweep.Sweeper{
 		Name: "aws_backup_framework",
 		F:    sweepFrameworks,
 	})
 
-	resource.AddTestSweepers("aws_backup_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_plan", &sweep.Sweeper{
 		Name: "aws_backup_plan",
 		F:    sweepPlans,
 		Dependencies: []string{
@@ -31,17 +31,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_selection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_selection", &sweep.Sweeper{
 		Name: "aws_backup_selection",
 		F:    sweepSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_report_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_report_plan", &sweep.Sweeper{
 		Name: "aws_backup_report_plan",
 		F:    sweepReportPlans,
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_plan", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_restore_testing_plan", &sweep.Sweeper{
 		Name: "aws_backup_restore_testing_plan",
 		F:    sweepRestoreTestingPlans,
 		Dependencies: []string{
@@ -49,27 +49,27 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_backup_restore_testing_selection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_restore_testing_selection", &sweep.Sweeper{
 		Name: "aws_backup_restore_testing_selection",
 		F:    sweepRestoreTestingSelections,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_lock_configuration", &sweep.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &sweep.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_policy", &sweep.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &sweep.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -80,8 +80,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepFrameworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepFrameworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -95,6 +94,7 @@ func sweepFrameworks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Framework sweep for %s: %s", region, err)
 			return nil
 		}
@@ -119,8 +119,7 @@ func sweepFrameworks(region string) error {
 	return nil
 }
 
-func sweepPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -134,6 +133,7 @@ func sweepPlans(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Plan sweep for %s: %s", region, err)
 			return nil
 		}
@@ -165,8 +165,7 @@ func sweepPlans(region string) error {
 	return nil
 }
 
-func sweepSelections(region string) error {
-	ctx := sweep.Context(region)
+func sweepSelections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -180,6 +179,7 @@ func sweepSelections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Selection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -227,8 +227,7 @@ func sweepSelections(region string) error {
 	return nil
 }
 
-func sweepReportPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepReportPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -242,6 +241,7 @@ func sweepReportPlans(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Report Plan sweep for %s: %s", region, err)
 			return nil
 		}
@@ -266,8 +266,7 @@ func sweepReportPlans(region string) error {
 	return nil
 }
 
-func sweepRestoreTestingPlans(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestoreTestingPlans(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -281,6 +280,7 @@ func sweepRestoreTestingPlans(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Restore Testing Plan sweep for %s: %s", region, err)
 			return nil
 		}
@@ -302,8 +302,7 @@ func sweepRestoreTestingPlans(region string) error {
 	return nil
 }
 
-func sweepRestoreTestingSelections(region string) error {
-	ctx := sweep.Context(region)
+func sweepRestoreTestingSelections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -317,6 +316,7 @@ func sweepRestoreTestingSelections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Restore Testing Plan sweep for %s: %s", region, err)
 			return nil
 		}
@@ -355,8 +355,7 @@ func sweepRestoreTestingSelections(region string) error {
 	return nil
 }
 
-func sweepVaultLockConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultLockConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -370,6 +369,7 @@ func sweepVaultLockConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Vault Lock Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -394,8 +394,7 @@ func sweepVaultLockConfigurations(region string) error {
 	return nil
 }
 
-func sweepVaultNotifications(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultNotifications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -409,6 +408,7 @@ func sweepVaultNotifications(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Vault Notifications sweep for %s: %s", region, err)
 			return nil
 		}
@@ -433,8 +433,7 @@ func sweepVaultNotifications(region string) error {
 	return nil
 }
 
-func sweepVaultPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaultPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -448,6 +447,7 @@ func sweepVaultPolicies(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Vault Policy sweep for %s: %s", region, err)
 			return nil
 		}
@@ -472,8 +472,7 @@ func sweepVaultPolicies(region string) error {
 	return nil
 }
 
-func sweepVaults(region string) error {
-	ctx := sweep.Context(region)
+func sweepVaults(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
@@ -487,6 +486,7 @@ func sweepVaults(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Backup Vault sweep for %s: %s", region, err)
 			return nil
 		}
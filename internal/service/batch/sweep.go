@@ -4,6 +4,7 @@
 package batch
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -15,7 +16,6 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -26,7 +26,7 @@ import (
 const propagationTimeout = 2 * time.Minute
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &sweep.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &sweep.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -42,12 +42,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &sweep.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
 
-	resource.AddTestSweepers("aws_batch_scheduling_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_scheduling_policy", &sweep.Sweeper{
 		Name: "aws_batch_scheduling_policy",
 		F:    sweepSchedulingPolicies,
 		Dependencies: []string{
@@ -56,8 +56,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepComputeEnvironments(region string) error {
-	ctx := sweep.Context(region)
+func sweepComputeEnvironments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -73,6 +72,7 @@ func sweepComputeEnvironments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Batch Compute Environment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -168,8 +168,7 @@ func sweepComputeEnvironments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepJobDefinitions(region string) error {
-	ctx := sweep.Context(region)
+func sweepJobDefinitions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -185,6 +184,7 @@ func sweepJobDefinitions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Batch Job Definition sweep for %s: %s", region, err)
 			return nil
 		}
@@ -211,8 +211,7 @@ func sweepJobDefinitions(region string) error {
 	return nil
 }
 
-func sweepJobQueues(region string) error {
-	ctx := sweep.Context(region)
+func sweepJobQueues(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -224,6 +223,7 @@ func sweepJobQueues(region string) error {
 	for jobQueue, err := range listJobQueues(ctx, conn, &input) {
 		if err != nil {
 			if awsv2.SkipSweepError(err) {
+				sweep.ReportSkipped(ctx, err)
 				log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
 				return nil
 			}
@@ -246,8 +246,7 @@ func sweepJobQueues(region string) error {
 	return nil
 }
 
-func sweepSchedulingPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepSchedulingPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -261,6 +260,7 @@ func sweepSchedulingPolicies(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Batch Scheduling Policy sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,26 +4,25 @@
 package chime
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/chimesdkvoice"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_chime_voice_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_chime_voice_connector", &sweep.Sweeper{
 		Name: "aws_chime_voice_connector",
 		F:    sweepVoiceConnectors,
 	})
 }
 
-func sweepVoiceConnectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepVoiceConnectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -38,6 +37,7 @@ func sweepVoiceConnectors(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Chime Voice Connector sweep for %s: %s", region, err)
 			return nil
 		}
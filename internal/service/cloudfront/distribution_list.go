@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudfront_distribution")
+func distributionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := distributionListResource{}
+	l.SetResourceSchema(resourceDistribution())
+	return &l
+}
+
+type distributionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type distributionListResourceModel struct{}
+
+func (l *distributionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query distributionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.CloudFrontClient(ctx)
+
+	tflog.Info(ctx, "Listing CloudFront distributions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input cloudfront.ListDistributionsInput
+		for item, err := range listDistributionsItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Multi-tenant distributions are managed by the aws_cloudfront_multitenant_distribution resource.
+			if item.ConnectionMode == awstypes.ConnectionModeTenantOnly {
+				continue
+			}
+
+			distributionID := aws.ToString(item.Id)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), distributionID)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(distributionID)
+
+			diags := resourceDistributionRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading CloudFront distribution", map[string]any{
+					names.AttrID: distributionID,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = distributionID
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
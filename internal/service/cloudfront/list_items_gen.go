@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "internal/generate/listpages/main.go -Items=ListDistributions:DistributionList.Items -- list_items_gen.go"; DO NOT EDIT.
+
+package cloudfront
+
+import (
+	"context"
+	"iter"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+func listDistributionsItems(ctx context.Context, conn *cloudfront.Client, input *cloudfront.ListDistributionsInput, optFns ...func(*cloudfront.Options)) iter.Seq2[awstypes.DistributionSummary, error] {
+	return func(yield func(awstypes.DistributionSummary, error) bool) {
+		pages := cloudfront.NewListDistributionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, optFns...)
+			if err != nil {
+				var zero awstypes.DistributionSummary
+				yield(zero, smarterr.NewError(err))
+				return
+			}
+
+			if page.DistributionList == nil {
+				continue
+			}
+
+			for _, v := range page.DistributionList.Items {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
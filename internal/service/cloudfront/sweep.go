@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -23,7 +22,7 @@ import (
 
 func RegisterSweepers() {
 	// Keep distribution sweeper as an old-style sweeper.
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &sweep.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
@@ -44,29 +43,28 @@ func RegisterSweepers() {
 	awsv2.Register("aws_cloudfront_vpc_origin", sweepVPCOrigins)
 }
 
-func sweepDistributions(region string) error {
+func sweepDistributions(ctx context.Context, region string) error {
 	var errs []error
 
 	// 1. Production Distributions
-	if err := sweepDistributionsByProductionOrStaging(region, false); err != nil {
+	if err := sweepDistributionsByProductionOrStaging(ctx, region, false); err != nil {
 		errs = append(errs, err)
 	}
 
 	// 2. Continuous Deployment Policies
-	if err := sweepContinuousDeploymentPolicies(region); err != nil {
+	if err := sweepContinuousDeploymentPolicies(ctx, region); err != nil {
 		errs = append(errs, err)
 	}
 
 	// 3. Staging Distributions
-	if err := sweepDistributionsByProductionOrStaging(region, true); err != nil {
+	if err := sweepDistributionsByProductionOrStaging(ctx, region, true); err != nil {
 		errs = append(errs, err)
 	}
 
 	return errors.Join(errs...)
 }
 
-func sweepDistributionsByProductionOrStaging(region string, staging bool) error {
-	ctx := sweep.Context(region)
+func sweepDistributionsByProductionOrStaging(ctx context.Context, region string, staging bool) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -86,6 +84,7 @@ func sweepDistributionsByProductionOrStaging(region string, staging bool) error
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping CloudFront Distribution sweep for %s: %s", region, err)
 			return nil
 		}
@@ -128,8 +127,7 @@ func sweepDistributionsByProductionOrStaging(region string, staging bool) error
 	return nil
 }
 
-func sweepContinuousDeploymentPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepContinuousDeploymentPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -153,6 +151,7 @@ func sweepContinuousDeploymentPolicies(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping CloudFront Continuous Deployment Policy sweep for %s: %s", region, err)
 		return nil
 	}
@@ -4,31 +4,30 @@
 package cloudtrail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudtrail", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudtrail", &sweep.Sweeper{
 		Name: "aws_cloudtrail",
 		F:    sweepTrails,
 	})
 
-	resource.AddTestSweepers("aws_cloudtrail_event_data_store", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudtrail_event_data_store", &sweep.Sweeper{
 		Name: "aws_cloudtrail_event_data_store",
 		F:    sweepEventDataStores,
 	})
 }
 
-func sweepTrails(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrails(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -42,6 +41,7 @@ func sweepTrails(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping CloudTrail Trail sweep for %s: %s", region, err)
 			return nil
 		}
@@ -90,8 +90,7 @@ func sweepTrails(region string) error {
 	return nil
 }
 
-func sweepEventDataStores(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventDataStores(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -105,6 +104,7 @@ func sweepEventDataStores(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping CloudTrail Event Data Store sweep for %s: %s", region, err)
 			return nil
 		}
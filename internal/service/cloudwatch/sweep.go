@@ -4,41 +4,40 @@
 package cloudwatch
 
 import (
+	"context"
 	"log"
 
 	"github.com/YakDriver/smarterr"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_composite_alarm", &sweep.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_dashboard", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_dashboard", &sweep.Sweeper{
 		Name: "aws_cloudwatch_dashboard",
 		F:    sweepDashboards,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_alarm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_metric_alarm", &sweep.Sweeper{
 		Name: "aws_cloudwatch_metric_alarm",
 		F:    sweepMetricAlarms,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_metric_stream", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_metric_stream", &sweep.Sweeper{
 		Name: "aws_cloudwatch_metric_stream",
 		F:    sweepMetricStreams,
 	})
 }
 
-func sweepCompositeAlarms(region string) error {
-	ctx := sweep.Context(region)
+func sweepCompositeAlarms(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return smarterr.NewError(err)
@@ -54,6 +53,7 @@ func sweepCompositeAlarms(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] SkippingCloudWatch Composite Alarm sweep for %s: %s", region, err)
 			return nil
 		}
@@ -80,8 +80,7 @@ func sweepCompositeAlarms(region string) error {
 	return nil
 }
 
-func sweepDashboards(region string) error {
-	ctx := sweep.Context(region)
+func sweepDashboards(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return smarterr.NewError(err)
@@ -95,6 +94,7 @@ func sweepDashboards(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] SkippingCloudWatch Dashboard sweep for %s: %s", region, err)
 			return nil
 		}
@@ -121,8 +121,7 @@ func sweepDashboards(region string) error {
 	return nil
 }
 
-func sweepMetricAlarms(region string) error {
-	ctx := sweep.Context(region)
+func sweepMetricAlarms(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return smarterr.NewError(err)
@@ -138,6 +137,7 @@ func sweepMetricAlarms(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] SkippingCloudWatch Metric Alarm sweep for %s: %s", region, err)
 			return nil
 		}
@@ -164,8 +164,7 @@ func sweepMetricAlarms(region string) error {
 	return nil
 }
 
-func sweepMetricStreams(region string) error {
-	ctx := sweep.Context(region)
+func sweepMetricStreams(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return smarterr.NewError(err)
@@ -179,6 +178,7 @@ func sweepMetricStreams(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] SkippingCloudWatch Metric Stream sweep for %s: %s", region, err)
 			return nil
 		}
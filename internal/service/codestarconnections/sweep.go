@@ -4,24 +4,24 @@
 package codestarconnections
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_codestarconnections_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_connection", &sweep.Sweeper{
 		Name: "aws_codestarconnections_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_codestarconnections_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codestarconnections_host", &sweep.Sweeper{
 		Name: "aws_codestarconnections_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -30,8 +30,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	if region == endpoints.UsGovEast1RegionID || region == endpoints.UsGovWest1RegionID {
 		log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for region: %s", region)
 		return nil
@@ -49,6 +48,7 @@ func sweepConnections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping CodeStar Connections Connection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -75,8 +75,7 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	if region == endpoints.UsGovEast1RegionID || region == endpoints.UsGovWest1RegionID {
 		log.Printf("[WARN] Skipping CodeStar Connections Host sweep for region: %s", region)
 		return nil
@@ -94,6 +93,7 @@ func sweepHosts(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping CodeStar Connections Host sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,25 +4,24 @@
 package cognitoidentity
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cognito_identity_pool", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cognito_identity_pool", &sweep.Sweeper{
 		Name: "aws_cognito_identity_pool",
 		F:    sweepIdentityPools,
 	})
 }
 
-func sweepIdentityPools(region string) error {
-	ctx := sweep.Context(region)
+func sweepIdentityPools(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -38,6 +37,7 @@ func sweepIdentityPools(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Cognito Identity Pool sweep for %s: %s", region, err)
 			return nil
 		}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -20,12 +19,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &sweep.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_config_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_config_rule", &sweep.Sweeper{
 		Name: "aws_config_config_rule",
 		F:    sweepConfigRules,
 		Dependencies: []string{
@@ -33,22 +32,22 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &sweep.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &sweep.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_conformance_pack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_conformance_pack", &sweep.Sweeper{
 		Name: "aws_config_conformance_pack",
 		F:    sweepConformancePacks,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &sweep.Sweeper{
 		Name: "aws_config_delivery_channel",
 		F:    sweepDeliveryChannels,
 		Dependencies: []string{
@@ -56,14 +55,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_config_remediation_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_remediation_configuration", &sweep.Sweeper{
 		Name: "aws_config_remediation_configuration",
 		F:    sweepRemediationConfigurations,
 	})
 }
 
-func sweepAggregateAuthorizations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAggregateAuthorizations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -77,6 +75,7 @@ func sweepAggregateAuthorizations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ConfigService Aggregate Authorization sweep for %s: %s", region, err)
 			return nil
 		}
@@ -103,8 +102,7 @@ func sweepAggregateAuthorizations(region string) error {
 	return nil
 }
 
-func sweepConfigRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -118,6 +116,7 @@ func sweepConfigRules(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ConfigService Config Rule sweep for %s: %s", region, err)
 			return nil
 		}
@@ -151,8 +150,7 @@ func sweepConfigRules(region string) error {
 	return nil
 }
 
-func sweepConfigurationAggregators(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationAggregators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -166,6 +164,7 @@ func sweepConfigurationAggregators(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ConfigService Configuration Aggregator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -213,8 +212,7 @@ func (s *configurationRecorderSweeper) Delete(ctx context.Context, optFns ...tfr
 	return sdk.NewSweepResource(r, d, s.client).Delete(ctx, optFns...)
 }
 
-func sweepConfigurationRecorder(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationRecorder(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -226,6 +224,7 @@ func sweepConfigurationRecorder(region string) error {
 	output, err := conn.DescribeConfigurationRecorders(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping ConfigService Configuration Recorder sweep for %s: %s", region, err)
 		return nil
 	}
@@ -247,8 +246,7 @@ func sweepConfigurationRecorder(region string) error {
 	return nil
 }
 
-func sweepConformancePacks(region string) error {
-	ctx := sweep.Context(region)
+func sweepConformancePacks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -262,6 +260,7 @@ func sweepConformancePacks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ConfigService Conformance Pack sweep for %s: %s", region, err)
 			return nil
 		}
@@ -288,8 +287,7 @@ func sweepConformancePacks(region string) error {
 	return nil
 }
 
-func sweepDeliveryChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepDeliveryChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -301,6 +299,7 @@ func sweepDeliveryChannels(region string) error {
 	output, err := conn.DescribeDeliveryChannels(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping ConfigService Delivery Channel sweep for %s: %s", region, err)
 		return nil
 	}
@@ -326,8 +325,7 @@ func sweepDeliveryChannels(region string) error {
 	return nil
 }
 
-func sweepRemediationConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepRemediationConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -342,6 +340,7 @@ func sweepRemediationConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ConfigService Remediation Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,26 +4,25 @@
 package cur
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	cur "github.com/aws/aws-sdk-go-v2/service/costandusagereportservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cur_report_definition", &sweep.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
 }
 
-func sweepReportDefinitions(region string) error {
-	ctx := sweep.Context(region)
+func sweepReportDefinitions(ctx context.Context, region string) error {
 	if region != endpoints.UsEast1RegionID {
 		log.Printf("[WARN] Skipping Cost And Usage Report Definition sweep for region: %s", region)
 		return nil
@@ -41,6 +40,7 @@ func sweepReportDefinitions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Cost And Usage Report Definition sweep for %s: %s", region, err)
 			return nil
 		}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -19,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &sweep.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 		Dependencies: []string{
@@ -28,7 +27,7 @@ func RegisterSweepers() {
 	})
 
 	// Pseudo-resource for any DataSync location resource type.
-	resource.AddTestSweepers("aws_datasync_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location", &sweep.Sweeper{
 		Name: "aws_datasync_location",
 		F:    sweepLocations,
 		Dependencies: []string{
@@ -36,14 +35,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &sweep.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
 }
 
-func sweepAgents(region string) error {
-	ctx := sweep.Context(region)
+func sweepAgents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -57,6 +55,7 @@ func sweepAgents(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DataSync Location sweep for %s: %s", region, err)
 			return nil
 		}
@@ -83,8 +82,7 @@ func sweepAgents(region string) error {
 	return nil
 }
 
-func sweepLocations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLocations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -98,6 +96,7 @@ func sweepLocations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DataSync Location sweep for %s: %s", region, err)
 			return nil
 		}
@@ -148,8 +147,7 @@ func (sweepable *sweepableLocation) Delete(ctx context.Context, optFns ...tfreso
 	return nil
 }
 
-func sweepTasks(region string) error {
-	ctx := sweep.Context(region)
+func sweepTasks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -163,6 +161,7 @@ func sweepTasks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DataSync Location sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,6 +4,7 @@
 package directconnect
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -13,23 +14,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &sweep.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &sweep.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &sweep.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -46,21 +46,20 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &sweep.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
 	})
 
-	resource.AddTestSweepers("aws_dx_macsec_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_macsec_key", &sweep.Sweeper{
 		Name:         "aws_dx_macsec_key",
 		F:            sweepMacSecKeys,
 		Dependencies: []string{},
 	})
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -72,6 +71,7 @@ func sweepConnections(region string) error {
 	output, err := conn.DescribeConnections(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect Connection sweep for %s: %s", region, err)
 		return nil
 	}
@@ -97,8 +97,7 @@ func sweepConnections(region string) error {
 	return nil
 }
 
-func sweepGatewayAssociationProposals(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociationProposals(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -136,6 +135,7 @@ func sweepGatewayAssociationProposals(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect Gateway Association Proposal sweep for %s: %s", region, err)
 		return nil
 	}
@@ -153,8 +153,7 @@ func sweepGatewayAssociationProposals(region string) error {
 	return nil
 }
 
-func sweepGatewayAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepGatewayAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -217,6 +216,7 @@ func sweepGatewayAssociations(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect Gateway Association sweep for %s: %s", region, err)
 		return nil
 	}
@@ -290,8 +290,7 @@ func sweepGatewayAssociations(region string) error {
 	return nil
 }
 
-func sweepGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -357,6 +356,7 @@ func sweepGateways(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect Gateway sweep for %s: %s", region, err)
 		return nil
 	}
@@ -374,8 +374,7 @@ func sweepGateways(region string) error {
 	return nil
 }
 
-func sweepLags(region string) error {
-	ctx := sweep.Context(region)
+func sweepLags(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -387,6 +386,7 @@ func sweepLags(region string) error {
 	output, err := conn.DescribeLags(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect LAG sweep for %s: %s", region, err)
 		return nil
 	}
@@ -412,8 +412,7 @@ func sweepLags(region string) error {
 	return nil
 }
 
-func sweepMacSecKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepMacSecKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -430,6 +429,7 @@ func sweepMacSecKeys(region string) error {
 	output, err := dxConn.DescribeConnections(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Direct Connect MACsec Keys sweep for %s: %s", region, err)
 		return nil
 	}
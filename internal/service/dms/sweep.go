@@ -4,18 +4,18 @@
 package dms
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_dms_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_endpoint", &sweep.Sweeper{
 		Name: "aws_dms_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -23,12 +23,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_config", &sweep.Sweeper{
 		Name: "aws_dms_replication_config",
 		F:    sweepReplicationConfigs,
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_instance", &sweep.Sweeper{
 		Name: "aws_dms_replication_instance",
 		F:    sweepReplicationInstances,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_subnet_group", &sweep.Sweeper{
 		Name: "aws_dms_replication_subnet_group",
 		F:    sweepReplicationSubnetGroups,
 		Dependencies: []string{
@@ -44,14 +44,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dms_replication_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dms_replication_task", &sweep.Sweeper{
 		Name: "aws_dms_replication_task",
 		F:    sweepReplicationTasks,
 	})
 }
 
-func sweepEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -65,6 +64,7 @@ func sweepEndpoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DMS Endpoint sweep for %s: %s", region, err)
 			return nil
 		}
@@ -92,8 +92,7 @@ func sweepEndpoints(region string) error {
 	return nil
 }
 
-func sweepReplicationConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationConfigs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -107,6 +106,7 @@ func sweepReplicationConfigs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DMS Replication Config sweep for %s: %s", region, err)
 			return nil
 		}
@@ -133,8 +133,7 @@ func sweepReplicationConfigs(region string) error {
 	return nil
 }
 
-func sweepReplicationInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -148,6 +147,7 @@ func sweepReplicationInstances(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DMS Replication Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -175,8 +175,7 @@ func sweepReplicationInstances(region string) error {
 	return nil
 }
 
-func sweepReplicationSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -190,6 +189,7 @@ func sweepReplicationSubnetGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DMS Replication Subnet Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -216,8 +216,7 @@ func sweepReplicationSubnetGroups(region string) error {
 	return nil
 }
 
-func sweepReplicationTasks(region string) error {
-	ctx := sweep.Context(region)
+func sweepReplicationTasks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -233,6 +232,7 @@ func sweepReplicationTasks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DMS Replication Task sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,13 +4,13 @@
 package docdbelastic
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/docdbelastic"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -18,14 +18,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_docdbelastic_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdbelastic_cluster", &sweep.Sweeper{
 		Name: "aws_docdbelastic_cluster",
 		F:    sweepClusters,
 	})
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID {
 		log.Printf("[WARN] Skipping DocDB Elastic Cluster sweep for region: %s", region)
 		return nil
@@ -43,6 +42,7 @@ func sweepClusters(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping DocDB Elastic Clusters sweep for %s: %s", region, err)
 			return nil
 		}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_dynamodb_table")
+func tableResourceAsListResource() inttypes.ListResourceForSDK {
+	l := tableListResource{}
+	l.SetResourceSchema(resourceTable())
+	return &l
+}
+
+type tableListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type tableListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *tableListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query tableListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.DynamoDBClient(ctx)
+
+	tflog.Info(ctx, "Listing DynamoDB tables")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input dynamodb.ListTablesInput
+		for item, err := range listTablesItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			tableName := item
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), tableName)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(tableName)
+
+			diags := resourceTableRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading DynamoDB table", map[string]any{
+					names.AttrID: tableName,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = tableName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
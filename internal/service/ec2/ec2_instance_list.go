@@ -30,6 +30,7 @@ import (
 func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
 	l := instanceListResource{}
 	l.SetResourceSchema(resourceInstance())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -78,6 +79,8 @@ func (l *instanceListResource) List(ctx context.Context, request list.ListReques
 		return
 	}
 
+	input.Filters = append(input.Filters, listQueryFilters(ctx, "tag:Name")...)
+
 	// If no instance-state filter is set, default to all states except terminated and shutting-down
 	if !slices.ContainsFunc(input.Filters, func(i awstypes.Filter) bool {
 		return aws.ToString(i.Name) == "instance-state-name" || aws.ToString(i.Name) == "instance-state-code"
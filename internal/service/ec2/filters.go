@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -18,6 +19,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -283,3 +285,45 @@ func newAttributeFilterList(m map[string]string) []awstypes.Filter {
 
 	return filters
 }
+
+// listQueryFilters returns EC2 API filters for the standard list resource query arguments
+// ("name_prefix" and "tag_filters") in the specified Context.
+// nameFilterName is the name of the EC2 API filter that matches the resource's name, e.g. "tag:Name".
+//
+// Query arguments that are converted to API filters are marked as applied so that they are not
+// also evaluated by the provider. Values containing EC2 filter wildcard characters and filters
+// for untagged resources cannot be expressed as API filters and are left for the provider.
+func listQueryFilters(ctx context.Context, nameFilterName string) []awstypes.Filter {
+	query, ok := listresource.QueryFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	hasWildcard := func(v string) bool {
+		return strings.ContainsAny(v, "*?")
+	}
+
+	var filters []awstypes.Filter
+
+	if v := query.NamePrefix; v != "" && !hasWildcard(v) {
+		filters = append(filters, newFilter(nameFilterName, []string{v + "*"}))
+		query.SetNamePrefixApplied()
+	}
+
+	for i, tagFilter := range query.TagFilters {
+		switch {
+		case tagFilter.Untagged:
+			continue
+		case len(tagFilter.Values) == 0:
+			filters = append(filters, newFilter("tag-key", []string{tagFilter.Key}))
+		case !slices.ContainsFunc(tagFilter.Values, hasWildcard):
+			filters = append(filters, newFilter("tag:"+tagFilter.Key, tagFilter.Values))
+		default:
+			continue
+		}
+
+		query.SetTagFilterApplied(i)
+	}
+
+	return filters
+}
@@ -15,7 +15,6 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -25,7 +24,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_customer_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_customer_gateway", &sweep.Sweeper{
 		Name: "aws_customer_gateway",
 		F:    sweepCustomerGateways,
 		Dependencies: []string{
@@ -35,12 +34,12 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_ec2_capacity_reservation", sweepCapacityReservations)
 
-	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_carrier_gateway", &sweep.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateways,
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_endpoint", &sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_endpoint",
 		F:    sweepClientVPNEndpoints,
 		Dependencies: []string{
@@ -48,17 +47,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_client_vpn_network_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_client_vpn_network_association", &sweep.Sweeper{
 		Name: "aws_ec2_client_vpn_network_association",
 		F:    sweepClientVPNNetworkAssociations,
 	})
 
-	resource.AddTestSweepers("aws_ec2_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_fleet", &sweep.Sweeper{
 		Name: "aws_ec2_fleet",
 		F:    sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_ebs_volume", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_volume", &sweep.Sweeper{
 		Name: "aws_ebs_volume",
 		Dependencies: []string{
 			"aws_instance",
@@ -66,7 +65,7 @@ func RegisterSweepers() {
 		F: sweepEBSVolumes,
 	})
 
-	resource.AddTestSweepers("aws_ebs_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ebs_snapshot", &sweep.Sweeper{
 		Name: "aws_ebs_snapshot",
 		F:    sweepEBSSnapshots,
 		Dependencies: []string{
@@ -74,12 +73,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_egress_only_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_egress_only_internet_gateway", &sweep.Sweeper{
 		Name: "aws_egress_only_internet_gateway",
 		F:    sweepEgressOnlyInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_eip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip", &sweep.Sweeper{
 		Name: "aws_eip",
 		Dependencies: []string{
 			"aws_eip_domain_name",
@@ -88,17 +87,17 @@ func RegisterSweepers() {
 		F: sweepEIPs,
 	})
 
-	resource.AddTestSweepers("aws_eip_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eip_domain_name", &sweep.Sweeper{
 		Name: "aws_eip_domain_name",
 		F:    sweepEIPDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_flow_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_flow_log", &sweep.Sweeper{
 		Name: "aws_flow_log",
 		F:    sweepFlowLogs,
 	})
 
-	resource.AddTestSweepers("aws_ec2_host", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_host", &sweep.Sweeper{
 		Name: "aws_ec2_host",
 		F:    sweepHosts,
 		Dependencies: []string{
@@ -106,7 +105,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_instance", &sweep.Sweeper{
 		Name: "aws_instance",
 		F:    sweepInstances,
 		Dependencies: []string{
@@ -116,7 +115,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_internet_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_internet_gateway", &sweep.Sweeper{
 		Name: "aws_internet_gateway",
 		Dependencies: []string{
 			"aws_subnet",
@@ -124,7 +123,7 @@ func RegisterSweepers() {
 		F: sweepInternetGateways,
 	})
 
-	resource.AddTestSweepers("aws_key_pair", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_key_pair", &sweep.Sweeper{
 		Name: "aws_key_pair",
 		Dependencies: []string{
 			"aws_elastic_beanstalk_environment",
@@ -135,7 +134,7 @@ func RegisterSweepers() {
 		F: sweepKeyPairs,
 	})
 
-	resource.AddTestSweepers("aws_launch_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_template", &sweep.Sweeper{
 		Name: "aws_launch_template",
 		Dependencies: []string{
 			"aws_autoscaling_group",
@@ -144,17 +143,17 @@ func RegisterSweepers() {
 		F: sweepLaunchTemplates,
 	})
 
-	resource.AddTestSweepers("aws_nat_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_nat_gateway", &sweep.Sweeper{
 		Name: "aws_nat_gateway",
 		F:    sweepNATGateways,
 	})
 
-	resource.AddTestSweepers("aws_network_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_acl", &sweep.Sweeper{
 		Name: "aws_network_acl",
 		F:    sweepNetworkACLs,
 	})
 
-	resource.AddTestSweepers("aws_network_interface", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_network_interface", &sweep.Sweeper{
 		Name: "aws_network_interface",
 		F:    sweepNetworkInterfaces,
 		Dependencies: []string{
@@ -173,7 +172,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_managed_prefix_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_managed_prefix_list", &sweep.Sweeper{
 		Name: "aws_ec2_managed_prefix_list",
 		F:    sweepManagedPrefixLists,
 		Dependencies: []string{
@@ -183,12 +182,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_network_insights_path", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_network_insights_path", &sweep.Sweeper{
 		Name: "aws_ec2_network_insights_path",
 		F:    sweepNetworkInsightsPaths,
 	})
 
-	resource.AddTestSweepers("aws_placement_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_placement_group", &sweep.Sweeper{
 		Name: "aws_placement_group",
 		F:    sweepPlacementGroups,
 		Dependencies: []string{
@@ -200,12 +199,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route_table", &sweep.Sweeper{
 		Name: "aws_route_table",
 		F:    sweepRouteTables,
 	})
 
-	resource.AddTestSweepers("aws_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_security_group", &sweep.Sweeper{
 		Name: "aws_security_group",
 		Dependencies: []string{
 			"aws_subnet",
@@ -213,12 +212,12 @@ func RegisterSweepers() {
 		F: sweepSecurityGroups,
 	})
 
-	resource.AddTestSweepers("aws_spot_fleet_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_fleet_request", &sweep.Sweeper{
 		Name: "aws_spot_fleet_request",
 		F:    sweepSpotFleetRequests,
 	})
 
-	resource.AddTestSweepers("aws_spot_instance_request", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_spot_instance_request", &sweep.Sweeper{
 		Name: "aws_spot_instance_request",
 		F:    sweepSpotInstanceRequests,
 	})
@@ -273,7 +272,7 @@ func RegisterSweepers() {
 		"aws_vpc_endpoint",
 	)
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_filter", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_filter",
 		F:    sweepTrafficMirrorFilters,
 		Dependencies: []string{
@@ -281,12 +280,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_session", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_session", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_session",
 		F:    sweepTrafficMirrorSessions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_traffic_mirror_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_traffic_mirror_target", &sweep.Sweeper{
 		Name: "aws_ec2_traffic_mirror_target",
 		F:    sweepTrafficMirrorTargets,
 		Dependencies: []string{
@@ -294,17 +293,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_peering_attachment", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_peering_attachment",
 		F:    sweepTransitGatewayPeeringAttachments,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_multicast_domain", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_multicast_domain",
 		F:    sweepTransitGatewayMulticastDomains,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway",
 		F:    sweepTransitGateways,
 		Dependencies: []string{
@@ -316,12 +315,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect_peer", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect_peer",
 		F:    sweepTransitGatewayConnectPeers,
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_connect", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_connect", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_connect",
 		F:    sweepTransitGatewayConnects,
 		Dependencies: []string{
@@ -330,7 +329,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_transit_gateway_vpc_attachment", &sweep.Sweeper{
 		Name: "aws_ec2_transit_gateway_vpc_attachment",
 		F:    sweepTransitGatewayVPCAttachments,
 		Dependencies: []string{
@@ -339,12 +338,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_dhcp_options", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_dhcp_options", &sweep.Sweeper{
 		Name: "aws_vpc_dhcp_options",
 		F:    sweepVPCDHCPOptions,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 		Dependencies: []string{
@@ -354,12 +353,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_connection_accepter", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint_connection_accepter",
 		F:    sweepVPCEndpointConnectionAccepters,
 	})
 
-	resource.AddTestSweepers("aws_vpc_endpoint_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_endpoint_service", &sweep.Sweeper{
 		Name: "aws_vpc_endpoint_service",
 		F:    sweepVPCEndpointServices,
 		Dependencies: []string{
@@ -367,12 +366,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_vpc_peering_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_peering_connection", &sweep.Sweeper{
 		Name: "aws_vpc_peering_connection",
 		F:    sweepVPCPeeringConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpc", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc", &sweep.Sweeper{
 		Name: "aws_vpc",
 		Dependencies: []string{
 			"aws_ec2_carrier_gateway",
@@ -395,12 +394,12 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_vpn_concentrator", sweepVPNConcentrators, "aws_vpn_connection")
 
-	resource.AddTestSweepers("aws_vpn_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_connection", &sweep.Sweeper{
 		Name: "aws_vpn_connection",
 		F:    sweepVPNConnections,
 	})
 
-	resource.AddTestSweepers("aws_vpn_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpn_gateway", &sweep.Sweeper{
 		Name: "aws_vpn_gateway",
 		F:    sweepVPNGateways,
 		Dependencies: []string{
@@ -413,22 +412,22 @@ func RegisterSweepers() {
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
 
-	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ami", &sweep.Sweeper{
 		Name: "aws_ami",
 		F:    sweepAMIs,
 	})
 
-	resource.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_vpc_network_performance_metric_subscription", &sweep.Sweeper{
 		Name: "aws_vpc_network_performance_metric_subscription",
 		F:    sweepNetworkPerformanceMetricSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_ec2_instance_connect_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ec2_instance_connect_endpoint", &sweep.Sweeper{
 		Name: "aws_ec2_instance_connect_endpoint",
 		F:    sweepInstanceConnectEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_trust_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_trust_provider", &sweep.Sweeper{
 		Name: "aws_verifiedaccess_trust_provider",
 		F:    sweepVerifiedAccessTrustProviders,
 		Dependencies: []string{
@@ -436,7 +435,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance_trust_provider_attachment", &sweep.Sweeper{
 		Name: "aws_verifiedaccess_instance_trust_provider_attachment",
 		F:    sweepVerifiedAccessTrustProviderAttachments,
 		Dependencies: []string{
@@ -444,7 +443,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_group", &sweep.Sweeper{
 		Name: "aws_verifiedaccess_group",
 		F:    sweepVerifiedAccessGroups,
 		Dependencies: []string{
@@ -452,12 +451,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_endpoint", &sweep.Sweeper{
 		Name: "aws_verifiedaccess_endpoint",
 		F:    sweepVerifiedAccessEndpoints,
 	})
 
-	resource.AddTestSweepers("aws_verifiedaccess_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedaccess_instance", &sweep.Sweeper{
 		Name: "aws_verifiedaccess_instance",
 		F:    sweepVerifiedAccessInstances,
 		Dependencies: []string{
@@ -504,8 +503,7 @@ func sweepCapacityReservations(ctx context.Context, client *conns.AWSClient) ([]
 	return sweepResources, nil
 }
 
-func sweepCarrierGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCarrierGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -519,6 +517,7 @@ func sweepCarrierGateways(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Carrier Gateway sweep for %s: %s", region, err)
 			return nil
 		}
@@ -545,8 +544,7 @@ func sweepCarrierGateways(region string) error {
 	return nil
 }
 
-func sweepClientVPNEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -560,6 +558,7 @@ func sweepClientVPNEndpoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Client VPN Endpoint sweep for %s: %s", region, err)
 			return nil
 		}
@@ -586,8 +585,7 @@ func sweepClientVPNEndpoints(region string) error {
 	return nil
 }
 
-func sweepClientVPNNetworkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepClientVPNNetworkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -602,6 +600,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Client VPN Network Association sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -648,8 +647,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -664,6 +662,7 @@ func sweepFleets(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Fleet sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -694,8 +693,7 @@ func sweepFleets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepEBSVolumes(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSVolumes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -709,6 +707,7 @@ func sweepEBSVolumes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 EBS Volume sweep for %s: %s", region, err)
 			return nil
 		}
@@ -742,8 +741,7 @@ func sweepEBSVolumes(region string) error {
 	return nil
 }
 
-func sweepEBSSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepEBSSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -759,6 +757,7 @@ func sweepEBSSnapshots(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EBS Snapshot sweep for %s: %s", region, err)
 			return nil
 		}
@@ -785,8 +784,7 @@ func sweepEBSSnapshots(region string) error {
 	return nil
 }
 
-func sweepEgressOnlyInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepEgressOnlyInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -800,6 +798,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Egress-only Internet Gateway sweep for %s: %s", region, err)
 			return nil
 		}
@@ -826,8 +825,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 	return nil
 }
 
-func sweepEIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -840,6 +838,7 @@ func sweepEIPs(region string) error {
 	output, err := conn.DescribeAddresses(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 EIP sweep for %s: %s", region, err)
 		return nil
 	}
@@ -876,8 +875,7 @@ func sweepEIPs(region string) error {
 	return nil
 }
 
-func sweepEIPDomainNames(region string) error {
-	ctx := sweep.Context(region)
+func sweepEIPDomainNames(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -893,6 +891,7 @@ func sweepEIPDomainNames(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EIP Domain Name sweep for %s: %s", region, err)
 			return nil
 		}
@@ -917,8 +916,7 @@ func sweepEIPDomainNames(region string) error {
 	return nil
 }
 
-func sweepFlowLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepFlowLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -932,6 +930,7 @@ func sweepFlowLogs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Flow Log sweep for %s: %s", region, err)
 			return nil
 		}
@@ -958,8 +957,7 @@ func sweepFlowLogs(region string) error {
 	return nil
 }
 
-func sweepHosts(region string) error {
-	ctx := sweep.Context(region)
+func sweepHosts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -973,6 +971,7 @@ func sweepHosts(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Host sweep for %s: %s", region, err)
 			return nil
 		}
@@ -999,8 +998,7 @@ func sweepHosts(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1014,6 +1012,7 @@ func sweepInstances(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1053,8 +1052,7 @@ func sweepInstances(region string) error {
 	return nil
 }
 
-func sweepInternetGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepInternetGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1089,6 +1087,7 @@ func sweepInternetGateways(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Internet Gateway sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1133,8 +1132,7 @@ func sweepInternetGateways(region string) error {
 	return nil
 }
 
-func sweepKeyPairs(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeyPairs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1146,6 +1144,7 @@ func sweepKeyPairs(region string) error {
 	output, err := conn.DescribeKeyPairs(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 Key Pair sweep for %s: %s", region, err)
 		return nil
 	}
@@ -1171,8 +1170,7 @@ func sweepKeyPairs(region string) error {
 	return nil
 }
 
-func sweepLaunchTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepLaunchTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1186,6 +1184,7 @@ func sweepLaunchTemplates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Launch Template sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1212,8 +1211,7 @@ func sweepLaunchTemplates(region string) error {
 	return nil
 }
 
-func sweepNATGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepNATGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1227,6 +1225,7 @@ func sweepNATGateways(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 NAT Gateway sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1253,8 +1252,7 @@ func sweepNATGateways(region string) error {
 	return nil
 }
 
-func sweepNetworkACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1268,6 +1266,7 @@ func sweepNetworkACLs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Network ACL sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1306,8 +1305,7 @@ func sweepNetworkACLs(region string) error {
 	return nil
 }
 
-func sweepNetworkInterfaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInterfaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1321,6 +1319,7 @@ func sweepNetworkInterfaces(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Network Interface sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1354,8 +1353,7 @@ func sweepNetworkInterfaces(region string) error {
 	return nil
 }
 
-func sweepManagedPrefixLists(region string) error {
-	ctx := sweep.Context(region)
+func sweepManagedPrefixLists(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1368,6 +1366,7 @@ func sweepManagedPrefixLists(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Managed Prefix List sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1399,8 +1398,7 @@ func sweepManagedPrefixLists(region string) error {
 	return nil
 }
 
-func sweepNetworkInsightsPaths(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkInsightsPaths(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1414,6 +1412,7 @@ func sweepNetworkInsightsPaths(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Insights Path sweep for %s: %s", region, errs)
 			return nil
 		}
@@ -1440,8 +1439,7 @@ func sweepNetworkInsightsPaths(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPlacementGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlacementGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1453,6 +1451,7 @@ func sweepPlacementGroups(region string) error {
 	output, err := conn.DescribePlacementGroups(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 Placement Group sweep for %s: %s", region, err)
 		return nil
 	}
@@ -1478,8 +1477,7 @@ func sweepPlacementGroups(region string) error {
 	return nil
 }
 
-func sweepRouteTables(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteTables(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1495,6 +1493,7 @@ func sweepRouteTables(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Route Table sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -1581,8 +1580,7 @@ func sweepRouteTables(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1598,6 +1596,7 @@ func sweepSecurityGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1641,6 +1640,7 @@ func sweepSecurityGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Security Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1681,8 +1681,7 @@ func sweepSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepSpotFleetRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotFleetRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1698,6 +1697,7 @@ func sweepSpotFleetRequests(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+			sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 			log.Printf("[WARN] Skipping EC2 Spot Fleet Requests sweep for %s: %s", region, errs)
 			return nil
 		}
@@ -1730,8 +1730,7 @@ func sweepSpotFleetRequests(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepSpotInstanceRequests(region string) error {
-	ctx := sweep.Context(region)
+func sweepSpotInstanceRequests(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -1747,6 +1746,7 @@ func sweepSpotInstanceRequests(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+			sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 			log.Printf("[WARN] Skipping EC2 Spot Instance Requests sweep for %s: %s", region, errs)
 			return nil
 		}
@@ -1811,8 +1811,7 @@ func sweepSubnets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 	return sweepResources, nil
 }
 
-func sweepTrafficMirrorFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1826,6 +1825,7 @@ func sweepTrafficMirrorFilters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Traffic Mirror Filter sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1852,8 +1852,7 @@ func sweepTrafficMirrorFilters(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorSessions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorSessions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1867,6 +1866,7 @@ func sweepTrafficMirrorSessions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Traffic Mirror Session sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1893,8 +1893,7 @@ func sweepTrafficMirrorSessions(region string) error {
 	return nil
 }
 
-func sweepTrafficMirrorTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficMirrorTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1908,6 +1907,7 @@ func sweepTrafficMirrorTargets(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Traffic Mirror Target sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1934,8 +1934,7 @@ func sweepTrafficMirrorTargets(region string) error {
 	return nil
 }
 
-func sweepTransitGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1949,6 +1948,7 @@ func sweepTransitGateways(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway sweep for %s: %s", region, err)
 			return nil
 		}
@@ -1979,8 +1979,7 @@ func sweepTransitGateways(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnectPeers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnectPeers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -1994,6 +1993,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway Connect Peer sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2024,8 +2024,7 @@ func sweepTransitGatewayConnectPeers(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayConnects(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayConnects(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2039,6 +2038,7 @@ func sweepTransitGatewayConnects(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway Connect sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2069,8 +2069,7 @@ func sweepTransitGatewayConnects(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayMulticastDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayMulticastDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2084,6 +2083,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway Multicast Domain sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2114,8 +2114,7 @@ func sweepTransitGatewayMulticastDomains(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeeringAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeeringAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2129,6 +2128,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway Peering Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2159,8 +2159,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2174,6 +2173,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Transit Gateway VPC Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2204,8 +2204,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCDHCPOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCDHCPOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2221,6 +2220,7 @@ func sweepVPCDHCPOptions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 DHCP Options Set sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2274,8 +2274,7 @@ func sweepVPCDHCPOptions(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2291,6 +2290,7 @@ func sweepVPCEndpoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 VPC Endpoint sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2329,8 +2329,7 @@ func sweepVPCEndpoints(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointConnectionAccepters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointConnectionAccepters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2346,6 +2345,7 @@ func sweepVPCEndpointConnectionAccepters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 VPC Endpoint Connection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2374,8 +2374,7 @@ func sweepVPCEndpointConnectionAccepters(region string) error {
 	return nil
 }
 
-func sweepVPCEndpointServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpointServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2391,6 +2390,7 @@ func sweepVPCEndpointServices(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 VPC Endpoint Service sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2424,8 +2424,7 @@ func sweepVPCEndpointServices(region string) error {
 	return nil
 }
 
-func sweepVPCPeeringConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCPeeringConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2441,6 +2440,7 @@ func sweepVPCPeeringConnections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 VPC Peering Connection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2467,8 +2467,7 @@ func sweepVPCPeeringConnections(region string) error {
 	return nil
 }
 
-func sweepVPCs(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2491,6 +2490,7 @@ func sweepVPCs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 VPC sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2517,8 +2517,7 @@ func sweepVPCs(region string) error {
 	return nil
 }
 
-func sweepVPNConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2532,6 +2531,7 @@ func sweepVPNConnections(region string) error {
 	output, err := conn.DescribeVpnConnections(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 VPN Connection sweep for %s: %s", region, err)
 		return nil
 	}
@@ -2561,8 +2561,7 @@ func sweepVPNConnections(region string) error {
 	return nil
 }
 
-func sweepVPNGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPNGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2574,6 +2573,7 @@ func sweepVPNGateways(region string) error {
 	output, err := conn.DescribeVpnGateways(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 VPN Gateway sweep for %s: %s", region, err)
 		return nil
 	}
@@ -2611,8 +2611,7 @@ func sweepVPNGateways(region string) error {
 	return nil
 }
 
-func sweepCustomerGateways(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomerGateways(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2624,6 +2623,7 @@ func sweepCustomerGateways(region string) error {
 	output, err := conn.DescribeCustomerGateways(ctx, &input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping EC2 Customer Gateway sweep for %s: %s", region, err)
 		return nil
 	}
@@ -2723,8 +2723,7 @@ func sweepIPAMResourceDiscoveries(ctx context.Context, client *conns.AWSClient)
 	return sweepResources, nil
 }
 
-func sweepAMIs(region string) error {
-	ctx := sweep.Context(region)
+func sweepAMIs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2742,6 +2741,7 @@ func sweepAMIs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping AMI sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2768,8 +2768,7 @@ func sweepAMIs(region string) error {
 	return nil
 }
 
-func sweepNetworkPerformanceMetricSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepNetworkPerformanceMetricSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2785,6 +2784,7 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 AWS Network Performance Metric Subscription sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2812,8 +2812,7 @@ func sweepNetworkPerformanceMetricSubscriptions(region string) error {
 	return nil
 }
 
-func sweepInstanceConnectEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstanceConnectEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -2827,6 +2826,7 @@ func sweepInstanceConnectEndpoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EC2 Instance Connect Endpoint sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2855,8 +2855,7 @@ func sweepInstanceConnectEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessEndpoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2872,6 +2871,7 @@ func sweepVerifiedAccessEndpoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Verified Access Endpoint sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2898,8 +2898,7 @@ func sweepVerifiedAccessEndpoints(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2915,6 +2914,7 @@ func sweepVerifiedAccessGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Verified Access Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2941,8 +2941,7 @@ func sweepVerifiedAccessGroups(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -2958,6 +2957,7 @@ func sweepVerifiedAccessInstances(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Verified Access Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -2984,8 +2984,7 @@ func sweepVerifiedAccessInstances(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviders(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviders(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -3001,6 +3000,7 @@ func sweepVerifiedAccessTrustProviders(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Verified Access Trust Provider sweep for %s: %s", region, err)
 			return nil
 		}
@@ -3027,8 +3027,7 @@ func sweepVerifiedAccessTrustProviders(region string) error {
 	return nil
 }
 
-func sweepVerifiedAccessTrustProviderAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVerifiedAccessTrustProviderAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -3044,6 +3043,7 @@ func sweepVerifiedAccessTrustProviderAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Verified Access Instance Trust Provider Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -33,6 +33,7 @@ import (
 func newVPCResourceAsListResource() inttypes.ListResourceForSDK {
 	l := vpcListResource{}
 	l.SetResourceSchema(resourceVPC())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -134,6 +135,8 @@ func (l *vpcListResource) List(ctx context.Context, request list.ListRequest, st
 		return
 	}
 
+	input.Filters = append(input.Filters, listQueryFilters(ctx, "tag:Name")...)
+
 	input.Filters = append(input.Filters, awstypes.Filter{
 		Name:   aws.String("is-default"),
 		Values: []string{"false"},
@@ -100,6 +100,8 @@ func (l *routeTableListResource) List(ctx context.Context, request list.ListRequ
 		return
 	}
 
+	input.Filters = append(input.Filters, listQueryFilters(ctx, "tag:Name")...)
+
 	tflog.Info(ctx, "Listing resources")
 
 	stream.Results = func(yield func(list.ListResult) bool) {
@@ -92,6 +92,8 @@ func (l *listResourceSecurityGroup) List(ctx context.Context, request list.ListR
 		return
 	}
 
+	input.Filters = append(input.Filters, listQueryFilters(ctx, "group-name")...)
+
 	stream.Results = func(yield func(list.ListResult) bool) {
 		for item, err := range listSecurityGroups(ctx, conn, &input) {
 			if err != nil {
@@ -32,6 +32,7 @@ import (
 func newSubnetResourceAsListResource() inttypes.ListResourceForSDK {
 	l := subnetListResource{}
 	l.SetResourceSchema(resourceSubnet())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -133,6 +134,8 @@ func (l *subnetListResource) List(ctx context.Context, request list.ListRequest,
 		return
 	}
 
+	input.Filters = append(input.Filters, listQueryFilters(ctx, "tag:Name")...)
+
 	input.Filters = append(input.Filters, awstypes.Filter{
 		Name:   aws.String("default-for-az"),
 		Values: []string{"false"},
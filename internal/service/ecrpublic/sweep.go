@@ -4,27 +4,26 @@
 package ecrpublic
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ecrpublic_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecrpublic_repository", &sweep.Sweeper{
 		Name: "aws_ecrpublic_repository",
 		F:    sweepRepositories,
 	})
 }
 
-func sweepRepositories(region string) error {
-	ctx := sweep.Context(region)
+func sweepRepositories(ctx context.Context, region string) error {
 	// "UnsupportedCommandException: DescribeRepositories command is only supported in us-east-1".
 	if region != endpoints.UsEast1RegionID {
 		log.Printf("[WARN] Skipping ECR Public Repository sweep for region: %s", region)
@@ -43,6 +42,7 @@ func sweepRepositories(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ECR Public Repository sweep for %s: %s", region, err)
 			return nil
 		}
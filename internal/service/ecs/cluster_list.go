@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ecs_cluster")
+func clusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := clusterListResource{}
+	l.SetResourceSchema(resourceCluster())
+	return &l
+}
+
+type clusterListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type clusterListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *clusterListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query clusterListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	tflog.Info(ctx, "Listing ECS clusters")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input ecs.ListClustersInput
+		for item, err := range listClustersItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := item
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(arn)
+
+			diags := resourceClusterRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading ECS cluster", map[string]any{
+					names.AttrID: arn,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = rd.Get(names.AttrName).(string)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -63,7 +63,7 @@ func TestAccECSCluster_basic(t *testing.T) {
 	})
 }
 
-func TestAccECSCluster_Identity_Basic(t *testing.T) {
+func TestAccECSCluster_Identity_importByName(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Cluster
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -96,7 +96,7 @@ func TestAccECSCluster_Identity_Basic(t *testing.T) {
 	})
 }
 
-func TestAccECSCluster_Identity_RegionOverride(t *testing.T) {
+func TestAccECSCluster_Identity_importByNameRegionOverride(t *testing.T) {
 	ctx := acctest.Context(t)
 
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -0,0 +1,317 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// redeployServicePollInterval defines polling cadence for the redeploy service action.
+	redeployServicePollInterval = 15 * time.Second
+
+	// deploymentStatusRolledBack is the status of a deployment that the deployment circuit breaker has rolled back.
+	deploymentStatusRolledBack = "ROLLED_BACK"
+)
+
+// @Action(aws_ecs_redeploy_service, name="Redeploy Service")
+func newRedeployServiceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &redeployServiceAction{}, nil
+}
+
+var (
+	_ action.Action = (*redeployServiceAction)(nil)
+)
+
+type redeployServiceAction struct {
+	framework.ActionWithModel[redeployServiceModel]
+}
+
+type redeployServiceModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *redeployServiceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment to complete, fail or be rolled back by the deployment circuit breaker.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the ECS cluster that hosts the service",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the ECS service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(21600),
+				},
+			},
+		},
+	}
+}
+
+func (a *redeployServiceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config redeployServiceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	serviceName := config.Service.ValueString()
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS redeploy service action", map[string]any{
+		"cluster":         cluster,
+		"service":         serviceName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, cluster)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Service Not Found",
+			fmt.Sprintf("ECS service %s was not found in cluster %s", serviceName, cluster),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Service",
+			fmt.Sprintf("Could not describe ECS service %s: %s", serviceName, err),
+		)
+		return
+	}
+
+	if status := aws.ToString(service.Status); status != serviceStatusActive {
+		resp.Diagnostics.AddError(
+			"Cannot Redeploy Service",
+			fmt.Sprintf("ECS service %s is in status '%s' and cannot be redeployed. Service must be in 'ACTIVE' status.", serviceName, status),
+		)
+		return
+	}
+
+	if v := service.DeploymentController; v != nil && v.Type != awstypes.DeploymentControllerTypeEcs {
+		resp.Diagnostics.AddError(
+			"Cannot Redeploy Service",
+			fmt.Sprintf("ECS service %s uses the '%s' deployment controller. Only services using the 'ECS' deployment controller can be redeployed.", serviceName, v.Type),
+		)
+		return
+	}
+
+	circuitBreaker, rollback := false, false
+	if v := service.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil {
+		circuitBreaker, rollback = v.DeploymentCircuitBreaker.Enable, v.DeploymentCircuitBreaker.Rollback
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s (deployment circuit breaker enabled: %t, rollback: %t)...", serviceName, circuitBreaker, rollback),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: true,
+		Service:            aws.String(serviceName),
+	}
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Redeploy Service",
+			fmt.Sprintf("Could not force new deployment of ECS service %s: %s", serviceName, err),
+		)
+		return
+	}
+
+	deployment := findPrimaryTaskSet(output.Service.Deployments)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Failed to Redeploy Service",
+			fmt.Sprintf("No primary deployment found for ECS service %s after forcing new deployment", serviceName),
+		)
+		return
+	}
+	deploymentID := aws.ToString(deployment.Id)
+
+	tflog.Info(ctx, "ECS service deployment started", map[string]any{
+		"service":       serviceName,
+		"deployment_id": deploymentID,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s started, waiting for completion...", deploymentID, serviceName),
+	})
+
+	var lastStatus actionwait.Status
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		service, err := findServiceNoTagsByTwoPartKey(ctx, conn, serviceName, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("describing service: %w", err)
+		}
+
+		deployment, status := deploymentStatus(service, deploymentID)
+
+		// Report every change of rollout state immediately.
+		if status != lastStatus && lastStatus != "" {
+			resp.SendProgress(action.InvokeProgressEvent{Message: deploymentProgressMessage(serviceName, deploymentID, status, deployment)})
+		}
+		lastStatus = status
+
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: status, Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(redeployServicePollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+			deploymentStatusRolledBack,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			deployment, _ := fr.Value.(*awstypes.Deployment)
+			resp.SendProgress(action.InvokeProgressEvent{Message: deploymentProgressMessage(serviceName, deploymentID, fr.Status, deployment)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, serviceName, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			var reason string
+			if fr.Value != nil {
+				reason = aws.ToString(fr.Value.RolloutStateReason)
+			}
+
+			switch failureErr.Status {
+			case deploymentStatusRolledBack:
+				resp.Diagnostics.AddError(
+					"Deployment Rolled Back",
+					fmt.Sprintf("Deployment %s of ECS service %s failed and was rolled back by the deployment circuit breaker. %s", deploymentID, serviceName, reason),
+				)
+			default:
+				resp.Diagnostics.AddError(
+					"Deployment Failed",
+					fmt.Sprintf("Deployment %s of ECS service %s failed. %s", deploymentID, serviceName, reason),
+				)
+			}
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected state: %s", deploymentID, serviceName, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", deploymentID, serviceName, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s completed successfully", deploymentID, serviceName),
+	})
+
+	tflog.Info(ctx, "ECS redeploy service action completed successfully", map[string]any{
+		"service":       serviceName,
+		"deployment_id": deploymentID,
+	})
+}
+
+// deploymentStatus returns the specified deployment of a service and its rollout state.
+// A deployment that has failed, or that has been replaced, after the deployment circuit breaker
+// started a rollback is reported as rolled back.
+func deploymentStatus(service *awstypes.Service, deploymentID string) (*awstypes.Deployment, actionwait.Status) {
+	rollback := false
+	if v := service.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil {
+		rollback = v.DeploymentCircuitBreaker.Rollback
+	}
+
+	var deployment *awstypes.Deployment
+	for _, v := range service.Deployments {
+		if aws.ToString(v.Id) == deploymentID {
+			deployment = &v
+			break
+		}
+	}
+
+	if deployment == nil {
+		// The deployment is removed from the service once it has been replaced.
+		if rollback {
+			return nil, deploymentStatusRolledBack
+		}
+		return nil, actionwait.Status(awstypes.DeploymentRolloutStateFailed)
+	}
+
+	switch deployment.RolloutState {
+	case awstypes.DeploymentRolloutStateFailed:
+		if primary := findPrimaryTaskSet(service.Deployments); rollback && primary != nil && aws.ToString(primary.Id) != deploymentID {
+			return deployment, deploymentStatusRolledBack
+		}
+		return deployment, actionwait.Status(awstypes.DeploymentRolloutStateFailed)
+	case "":
+		// Deployments of services behind a Classic Load Balancer have no rollout state.
+		if len(service.Deployments) == 1 && deployment.RunningCount == deployment.DesiredCount {
+			return deployment, actionwait.Status(awstypes.DeploymentRolloutStateCompleted)
+		}
+		return deployment, actionwait.Status(awstypes.DeploymentRolloutStateInProgress)
+	default:
+		return deployment, actionwait.Status(deployment.RolloutState)
+	}
+}
+
+func deploymentProgressMessage(serviceName, deploymentID string, status actionwait.Status, deployment *awstypes.Deployment) string {
+	if deployment == nil {
+		return fmt.Sprintf("Deployment %s of ECS service %s is %s", deploymentID, serviceName, status)
+	}
+
+	message := fmt.Sprintf("Deployment %s of ECS service %s is %s: %d running, %d pending, %d desired", deploymentID, serviceName, status, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount)
+	if deployment.FailedTasks > 0 {
+		message += fmt.Sprintf(", %d failed", deployment.FailedTasks)
+	}
+	if v := aws.ToString(deployment.RolloutStateReason); v != "" {
+		message += " (" + v + ")"
+	}
+
+	return message
+}
@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSRedeployServiceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	resourceName := "aws_ecs_service.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedeployServiceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					testAccCheckServiceRedeployed(ctx, &service),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceRedeployed(ctx context.Context, v *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSClient(ctx)
+
+		output, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, aws.ToString(v.ServiceName), aws.ToString(v.ClusterArn))
+		if err != nil {
+			return err
+		}
+
+		for _, deployment := range output.Deployments {
+			if aws.ToString(deployment.Status) != "PRIMARY" {
+				continue
+			}
+
+			if deployment.RolloutState != awstypes.DeploymentRolloutStateCompleted {
+				return fmt.Errorf("ECS Service (%s) primary deployment rollout state is %s, expected %s", aws.ToString(v.ServiceName), deployment.RolloutState, awstypes.DeploymentRolloutStateCompleted)
+			}
+		}
+
+		// The initial deployment and the forced deployment.
+		input := ecs.ListServiceDeploymentsInput{
+			Cluster: v.ClusterArn,
+			Service: v.ServiceArn,
+		}
+		deployments, err := conn.ListServiceDeployments(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(deployments.ServiceDeployments); n < 2 {
+			return fmt.Errorf("ECS Service (%s) has %d deployments, expected at least 2", aws.ToString(v.ServiceName), n)
+		}
+
+		return nil
+	}
+}
+
+func testAccRedeployServiceActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  cluster         = aws_ecs_cluster.test.id
+  desired_count   = 0
+  name            = %[1]q
+  task_definition = aws_ecs_task_definition.test.arn
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+}
+
+action "aws_ecs_redeploy_service" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+    timeout = 600
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_redeploy_service.test]
+    }
+  }
+}
+`, rName)
+}
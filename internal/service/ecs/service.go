@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
@@ -48,6 +49,12 @@ import (
 
 // @SDKResource("aws_ecs_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Service")
+// @Testing(importIgnore="task_definition;wait_for_steady_state")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceService() *schema.Resource {
 	// Resource with v0 schema (provider v5.58.0).
 	resourceV0 := &schema.Resource{
@@ -1991,35 +1998,55 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
+	// Services can also be imported using "cluster-name/service-name".
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		parts := strings.Split(id, "/")
+		if len(parts) != 2 {
+			return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", id)
+		}
+		clusterName := parts[0]
+		serviceName := parts[1]
+		log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
+
+		region := d.Get(names.AttrRegion).(string)
+		d.SetId(arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			Service:   "ecs",
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
+		}.String())
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
 	}
-	clusterName := parts[0]
-	serviceName := parts[1]
-	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
 
-	region := d.Get(names.AttrRegion).(string)
+	// The cluster name is part of the long service ARN format.
+	// The legacy short service ARN format ("service/service-name") has no cluster name and, as with the ECS API, the default cluster is assumed.
+	serviceARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	var clusterName string
+	switch parts := strings.Split(serviceARN.Resource, "/"); len(parts) {
+	case 2:
+		clusterName = "default"
+	case 3:
+		clusterName = parts[1]
+	default:
+		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'arn:PARTITION:ecs:REGION:ACCOUNT:service/cluster-name/service-name'", d.Id())
+	}
 
 	clusterARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
+		Partition: serviceARN.Partition,
+		Region:    serviceARN.Region,
 		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("cluster/%s", clusterName),
+		AccountID: serviceARN.AccountID,
+		Resource:  "cluster/" + clusterName,
 	}.String()
 	d.Set("cluster", clusterARN)
 
-	serviceARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
-	}.String()
-	d.SetId(serviceARN)
-	d.Set(names.AttrARN, serviceARN)
-
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -202,7 +202,7 @@ func TestAccECSService_basic(t *testing.T) {
 	})
 }
 
-func TestAccECSService_Identity_Basic(t *testing.T) {
+func TestAccECSService_Identity_importByID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -240,7 +240,7 @@ func TestAccECSService_Identity_Basic(t *testing.T) {
 	})
 }
 
-func TestAccECSService_Identity_RegionOverride(t *testing.T) {
+func TestAccECSService_Identity_importByIDRegionOverride(t *testing.T) {
 	ctx := acctest.Context(t)
 
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -33,6 +33,11 @@ import (
 
 // @SDKResource("aws_efs_file_system", name="File System")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/efs/types;awstypes;awstypes.FileSystemDescription")
+// @Testing(existsFunc="testAccCheckFileSystem")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
@@ -40,10 +45,6 @@ func resourceFileSystem() *schema.Resource {
 		UpdateWithoutTimeout: resourceFileSystemUpdate,
 		DeleteWithoutTimeout: resourceFileSystemDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_efs_file_system")
+func fileSystemResourceAsListResource() inttypes.ListResourceForSDK {
+	l := fileSystemListResource{}
+	l.SetResourceSchema(resourceFileSystem())
+	return &l
+}
+
+type fileSystemListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type fileSystemListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *fileSystemListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query fileSystemListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EFSClient(ctx)
+
+	tflog.Info(ctx, "Listing EFS file systems")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input efs.DescribeFileSystemsInput
+		for item, err := range describeFileSystemsItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			fileSystemID := aws.ToString(item.FileSystemId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), fileSystemID)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(fileSystemID)
+
+			diags := resourceFileSystemRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading EFS file system", map[string]any{
+					names.AttrID: fileSystemID,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = fileSystemID
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
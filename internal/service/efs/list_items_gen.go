@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "internal/generate/listpages/main.go -Items=DescribeFileSystems:FileSystems -- list_items_gen.go"; DO NOT EDIT.
+
+package efs
+
+import (
+	"context"
+	"iter"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/efs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/efs/types"
+)
+
+func describeFileSystemsItems(ctx context.Context, conn *efs.Client, input *efs.DescribeFileSystemsInput, optFns ...func(*efs.Options)) iter.Seq2[awstypes.FileSystemDescription, error] {
+	return func(yield func(awstypes.FileSystemDescription, error) bool) {
+		pages := efs.NewDescribeFileSystemsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, optFns...)
+			if err != nil {
+				var zero awstypes.FileSystemDescription
+				yield(zero, smarterr.NewError(err))
+				return
+			}
+
+			for _, v := range page.FileSystems {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
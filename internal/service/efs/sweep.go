@@ -4,23 +4,23 @@
 package efs
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &sweep.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &sweep.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -30,14 +30,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &sweep.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -51,6 +50,7 @@ func sweepAccessPoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EFS Access Point sweep for %s: %s", region, err)
 			return nil
 		}
@@ -92,8 +92,7 @@ func sweepAccessPoints(region string) error {
 	return nil
 }
 
-func sweepFileSystems(region string) error {
-	ctx := sweep.Context(region)
+func sweepFileSystems(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -107,6 +106,7 @@ func sweepFileSystems(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EFS File System sweep for %s: %s", region, err)
 			return nil
 		}
@@ -133,8 +133,7 @@ func sweepFileSystems(region string) error {
 	return nil
 }
 
-func sweepMountTargets(region string) error {
-	ctx := sweep.Context(region)
+func sweepMountTargets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -148,6 +147,7 @@ func sweepMountTargets(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EFS Mount Target sweep for %s: %s", region, err)
 			return nil
 		}
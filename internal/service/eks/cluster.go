@@ -35,6 +35,12 @@ import (
 
 // @SDKResource("aws_eks_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Cluster")
+// @Testing(idAttrDuplicates="name")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -42,10 +48,6 @@ func resourceCluster() *schema.Resource {
 		UpdateWithoutTimeout: resourceClusterUpdate,
 		DeleteWithoutTimeout: resourceClusterDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -1077,8 +1079,8 @@ func findUpdate(ctx context.Context, conn *eks.Client, input *eks.DescribeUpdate
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) sdkretry.StateRefreshFunc {
-	return func() (any, string, error) {
+func statusCluster(conn *eks.Client, name string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
 
 		if retry.NotFound(err) {
@@ -1093,8 +1095,8 @@ func statusCluster(ctx context.Context, conn *eks.Client, name string) sdkretry.
 	}
 }
 
-func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) sdkretry.StateRefreshFunc {
-	return func() (any, string, error) {
+func statusUpdate(conn *eks.Client, name, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
 		output, err := findClusterUpdateByTwoPartKey(ctx, conn, name, id)
 
 		if retry.NotFound(err) {
@@ -1110,10 +1112,10 @@ func statusUpdate(ctx context.Context, conn *eks.Client, name, id string) sdkret
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &sdkretry.StateChangeConf{
+	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
 		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
+		Refresh: statusCluster(conn, name),
 		Timeout: timeout,
 	}
 
@@ -1127,10 +1129,10 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &sdkretry.StateChangeConf{
+	stateConf := &retry.StateChangeConf{
 		Pending:    enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
 		Target:     []string{},
-		Refresh:    statusCluster(ctx, conn, name),
+		Refresh:    statusCluster(conn, name),
 		Timeout:    timeout,
 		MinTimeout: 10 * time.Second,
 		// An attempt to avoid "ResourceInUseException: Cluster already exists with name: ..." errors
@@ -1148,10 +1150,10 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &sdkretry.StateChangeConf{
+	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusUpdate(ctx, conn, name, id),
+		Refresh: statusUpdate(conn, name, id),
 		Timeout: timeout,
 	}
 
@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -2707,3 +2710,24 @@ resource "aws_eks_cluster" "test" {
 }
 `, rName, tier))
 }
+
+func TestWaitClusterCreated_warning(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.WaitWarningThreshold, "1ns")
+
+	ctx, cancel := context.WithCancel(retry.NewWarningsContext(t.Context()))
+	cancel()
+
+	conn := eks.New(eks.Options{Region: endpoints.UsWest2RegionID})
+	if _, err := tfeks.WaitClusterCreated(ctx, conn, "test", time.Minute); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+
+	// The wait is reported as long-running by the provider's state change wait.
+	warnings := retry.WarningsFromContext(ctx)
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d", len(warnings))
+	}
+	if want := "eks.waitClusterCreated"; !strings.HasPrefix(warnings[0].Detail, want) {
+		t.Errorf("expected warning detail to start with %q, got %q", want, warnings[0].Detail)
+	}
+}
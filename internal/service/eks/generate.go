@@ -2,7 +2,9 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -KVTValues -UpdateTags
+//go:generate go run ../../generate/listpages/main.go -Items=ListClusters:Clusters,ListNodegroups:Nodegroups -- list_items_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/identitytests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package eks
@@ -31,12 +31,21 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_eks_node_group", name="Node Group")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("cluster_name")
+// @IdentityAttribute("node_group_name")
+// @IdAttrFormat("{cluster_name}:{node_group_name}")
+// @ImportIDHandler("nodeGroupImportID")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eks/types;types.Nodegroup")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceNodeGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNodeGroupCreate,
@@ -44,10 +53,6 @@ func resourceNodeGroup() *schema.Resource {
 		UpdateWithoutTimeout: resourceNodeGroupUpdate,
 		DeleteWithoutTimeout: resourceNodeGroupDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -1290,3 +1295,25 @@ func flattenTaints(apiObjects []types.Taint) []any {
 
 	return tfList
 }
+
+var _ inttypes.SDKv2ImportID = nodeGroupImportID{}
+
+type nodeGroupImportID struct{}
+
+func (nodeGroupImportID) Create(d *schema.ResourceData) string {
+	return NodeGroupCreateResourceID(d.Get(names.AttrClusterName).(string), d.Get("node_group_name").(string))
+}
+
+func (nodeGroupImportID) Parse(id string) (string, map[string]string, error) {
+	clusterName, nodeGroupName, err := NodeGroupParseResourceID(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result := map[string]string{
+		names.AttrClusterName: clusterName,
+		"node_group_name":     nodeGroupName,
+	}
+
+	return id, result, nil
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_eks_node_group")
+func nodeGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := nodeGroupListResource{}
+	l.SetResourceSchema(resourceNodeGroup())
+	return &l
+}
+
+type nodeGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type nodeGroupListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *nodeGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query nodeGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EKSClient(ctx)
+
+	tflog.Info(ctx, "Listing EKS node groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// Node groups are listed per cluster.
+		var input eks.ListClustersInput
+		for clusterName, err := range listClustersItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			input := eks.ListNodegroupsInput{
+				ClusterName: aws.String(clusterName),
+			}
+			for item, err := range listNodegroupsItems(ctx, conn, &input) {
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				id := NodeGroupCreateResourceID(clusterName, item)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+				result := request.NewListResult(ctx)
+				rd := l.ResourceData()
+				rd.SetId(id)
+
+				diags := resourceNodeGroupRead(ctx, rd, awsClient)
+				if diags.HasError() || rd.Id() == "" {
+					// Resource can't be read or is logically deleted.
+					// Log and continue.
+					tflog.Error(ctx, "Reading EKS node group", map[string]any{
+						names.AttrID: id,
+						"diags":      sdkdiag.DiagnosticsString(diags),
+					})
+					continue
+				}
+
+				result.DisplayName = item
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
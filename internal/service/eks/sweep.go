@@ -4,6 +4,7 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,19 +13,18 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_eks_addon", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_addon", &sweep.Sweeper{
 		Name: "aws_eks_addon",
 		F:    sweepAddons,
 	})
 
-	resource.AddTestSweepers("aws_eks_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_cluster", &sweep.Sweeper{
 		Name: "aws_eks_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -36,24 +36,23 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_eks_fargate_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_fargate_profile", &sweep.Sweeper{
 		Name: "aws_eks_fargate_profile",
 		F:    sweepFargateProfiles,
 	})
 
-	resource.AddTestSweepers("aws_eks_identity_provider_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_identity_provider_config", &sweep.Sweeper{
 		Name: "aws_eks_identity_provider_config",
 		F:    sweepIdentityProvidersConfig,
 	})
 
-	resource.AddTestSweepers("aws_eks_node_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_eks_node_group", &sweep.Sweeper{
 		Name: "aws_eks_node_group",
 		F:    sweepNodeGroups,
 	})
 }
 
-func sweepAddons(region string) error {
-	ctx := sweep.Context(region)
+func sweepAddons(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -68,6 +67,7 @@ func sweepAddons(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EKS Add-On sweep for %s: %s", region, err)
 			return nil
 		}
@@ -121,8 +121,7 @@ func sweepAddons(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -136,6 +135,7 @@ func sweepClusters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EKS Cluster sweep for %s: %s", region, err)
 			return nil
 		}
@@ -177,8 +177,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepFargateProfiles(region string) error {
-	ctx := sweep.Context(region)
+func sweepFargateProfiles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -193,6 +192,7 @@ func sweepFargateProfiles(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EKS Fargate Profile sweep for %s: %s", region, err)
 			return nil
 		}
@@ -246,8 +246,7 @@ func sweepFargateProfiles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentityProvidersConfig(region string) error {
-	ctx := sweep.Context(region)
+func sweepIdentityProvidersConfig(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -262,6 +261,7 @@ func sweepIdentityProvidersConfig(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EKS Identity Provider Config sweep for %s: %s", region, err)
 			return nil
 		}
@@ -315,8 +315,7 @@ func sweepIdentityProvidersConfig(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepNodeGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepNodeGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -331,6 +330,7 @@ func sweepNodeGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EKS Node Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,25 +4,24 @@
 package elb
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &sweep.Sweeper{
 		Name: "aws_elb",
 		F:    sweepLoadBalancers,
 	})
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -36,6 +35,7 @@ func sweepLoadBalancers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ELB Classic Load Balancer sweep for %s: %s", region, err)
 			return nil
 		}
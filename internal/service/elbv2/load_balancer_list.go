@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lb")
+func loadBalancerResourceAsListResource() inttypes.ListResourceForSDK {
+	l := loadBalancerListResource{}
+	l.SetResourceSchema(resourceLoadBalancer())
+	return &l
+}
+
+type loadBalancerListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type loadBalancerListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *loadBalancerListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query loadBalancerListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ELBV2Client(ctx)
+
+	tflog.Info(ctx, "Listing ELBv2 load balancers")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input elasticloadbalancingv2.DescribeLoadBalancersInput
+		for item, err := range describeLoadBalancersItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(item.LoadBalancerArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(arn)
+
+			diags := resourceLoadBalancerRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading ELBv2 load balancer", map[string]any{
+					names.AttrID: arn,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = aws.ToString(item.LoadBalancerName)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
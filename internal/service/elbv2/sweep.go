@@ -10,7 +10,6 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -18,7 +17,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb", &sweep.Sweeper{
 		Name: "aws_lb",
 		F:    sweepLoadBalancers,
 		Dependencies: []string{
@@ -28,7 +27,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_target_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_target_group", &sweep.Sweeper{
 		Name: "aws_lb_target_group",
 		F:    sweepTargetGroups,
 		Dependencies: []string{
@@ -36,7 +35,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_lb_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lb_listener", &sweep.Sweeper{
 		Name: "aws_lb_listener",
 		F:    sweepListeners,
 	})
@@ -44,8 +43,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_lb_trust_store", sweepTrustStore)
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -59,6 +57,7 @@ func sweepLoadBalancers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ELBv2 Load Balancer sweep for %s: %s", region, err)
 			return nil
 		}
@@ -85,8 +84,7 @@ func sweepLoadBalancers(region string) error {
 	return nil
 }
 
-func sweepTargetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepTargetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -100,6 +98,7 @@ func sweepTargetGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ELBv2 Target Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -126,8 +125,7 @@ func sweepTargetGroups(region string) error {
 	return nil
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -141,6 +139,7 @@ func sweepListeners(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping ELBv2 Listener sweep for %s: %s", region, err)
 			return nil
 		}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lb_target_group")
+func targetGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := targetGroupListResource{}
+	l.SetResourceSchema(resourceTargetGroup())
+	return &l
+}
+
+type targetGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type targetGroupListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *targetGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query targetGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ELBV2Client(ctx)
+
+	tflog.Info(ctx, "Listing ELBv2 target groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input elasticloadbalancingv2.DescribeTargetGroupsInput
+		for item, err := range describeTargetGroupsItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			arn := aws.ToString(item.TargetGroupArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), arn)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(arn)
+
+			diags := resourceTargetGroupRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading ELBv2 target group", map[string]any{
+					names.AttrID: arn,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = aws.ToString(item.TargetGroupName)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -4,31 +4,30 @@
 package emrcontainers
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emrcontainers"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/emrcontainers/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_virtual_cluster", &sweep.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
 	})
 
-	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emrcontainers_job_template", &sweep.Sweeper{
 		Name: "aws_emrcontainers_job_template",
 		F:    sweepJobTemplates,
 	})
 }
 
-func sweepVirtualClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVirtualClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -42,6 +41,7 @@ func sweepVirtualClusters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EMR Containers Virtual Cluster sweep for %s: %s", region, err)
 			return nil
 		}
@@ -72,8 +72,7 @@ func sweepVirtualClusters(region string) error {
 	return nil
 }
 
-func sweepJobTemplates(region string) error {
-	ctx := sweep.Context(region)
+func sweepJobTemplates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -87,6 +86,7 @@ func sweepJobTemplates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping EMR Containers Job Template sweep for %s: %s", region, err)
 			return nil
 		}
@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -26,15 +25,14 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_fms_admin_account", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_fms_admin_account", &sweep.Sweeper{
 		Name: "aws_fms_admin_account",
 		F:    sweepAdminAccount,
 	})
 }
 
 // TODO: This sweeper has custom skip logic, so can't use a `sweep.SweeperFn`
-func sweepAdminAccount(region string) error {
-	ctx := sweep.Context(region)
+func sweepAdminAccount(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -49,6 +47,7 @@ func sweepAdminAccount(region string) error {
 		return nil
 	}
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		sweep.ReportSkipped(ctx, err)
 		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
 			"error": err.Error(),
 		})
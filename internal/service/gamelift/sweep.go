@@ -4,18 +4,18 @@
 package gamelift
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/gamelift"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &sweep.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -23,17 +23,17 @@ func RegisterSweepers() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &sweep.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_script", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_script", &sweep.Sweeper{
 		Name: "aws_gamelift_script",
 		F:    sweepScripts,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &sweep.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -41,19 +41,18 @@ func RegisterSweepers() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_server_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_server_group", &sweep.Sweeper{
 		Name: "aws_gamelift_game_server_group",
 		F:    sweepGameServerGroups,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &sweep.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
 }
 
-func sweepAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -67,6 +66,7 @@ func sweepAliases(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Alias sweep for %s: %s", region, err)
 			return nil
 		}
@@ -93,8 +93,7 @@ func sweepAliases(region string) error {
 	return nil
 }
 
-func sweepBuilds(region string) error {
-	ctx := sweep.Context(region)
+func sweepBuilds(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -108,6 +107,7 @@ func sweepBuilds(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Build sweep for %s: %s", region, err)
 			return nil
 		}
@@ -134,8 +134,7 @@ func sweepBuilds(region string) error {
 	return nil
 }
 
-func sweepScripts(region string) error {
-	ctx := sweep.Context(region)
+func sweepScripts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -149,6 +148,7 @@ func sweepScripts(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Script sweep for %s: %s", region, err)
 			return nil
 		}
@@ -175,8 +175,7 @@ func sweepScripts(region string) error {
 	return nil
 }
 
-func sweepFleets(region string) error {
-	ctx := sweep.Context(region)
+func sweepFleets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -190,6 +189,7 @@ func sweepFleets(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Fleet sweep for %s: %s", region, err)
 			return nil
 		}
@@ -216,8 +216,7 @@ func sweepFleets(region string) error {
 	return nil
 }
 
-func sweepGameServerGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGameServerGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -231,6 +230,7 @@ func sweepGameServerGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Game Server Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -257,8 +257,7 @@ func sweepGameServerGroups(region string) error {
 	return nil
 }
 
-func sweepGameSessionQueue(region string) error {
-	ctx := sweep.Context(region)
+func sweepGameSessionQueue(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -272,6 +271,7 @@ func sweepGameSessionQueue(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GameLift Game Session Queue sweep for %s: %s", region, err)
 			return nil
 		}
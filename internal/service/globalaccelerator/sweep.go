@@ -4,18 +4,18 @@
 package globalaccelerator
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 		Dependencies: []string{
@@ -23,7 +23,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_listener", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_listener",
 		F:    sweepListeners,
 		Dependencies: []string{
@@ -31,12 +31,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_endpoint_group", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_endpoint_group",
 		F:    sweepEndpointGroups,
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_accelerator",
 		F:    sweepCustomRoutingAccelerators,
 		Dependencies: []string{
@@ -44,7 +44,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_listener", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_listener",
 		F:    sweepCustomRoutingListeners,
 		Dependencies: []string{
@@ -52,14 +52,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_custom_routing_endpoint_group", &sweep.Sweeper{
 		Name: "aws_globalaccelerator_custom_routing_endpoint_group",
 		F:    sweepCustomRoutingEndpointGroups,
 	})
 }
 
-func sweepAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -73,6 +72,7 @@ func sweepAccelerators(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Accelerator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -99,8 +99,7 @@ func sweepAccelerators(region string) error {
 	return nil
 }
 
-func sweepEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -114,6 +113,7 @@ func sweepEndpointGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Endpoint Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -170,8 +170,7 @@ func sweepEndpointGroups(region string) error {
 	return nil
 }
 
-func sweepListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -185,6 +184,7 @@ func sweepListeners(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Endpoint Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -226,8 +226,7 @@ func sweepListeners(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingAccelerators(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingAccelerators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -241,6 +240,7 @@ func sweepCustomRoutingAccelerators(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Custom Routing Accelerator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -267,8 +267,7 @@ func sweepCustomRoutingAccelerators(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingEndpointGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingEndpointGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -282,6 +281,7 @@ func sweepCustomRoutingEndpointGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Custom Routing Accelerator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -338,8 +338,7 @@ func sweepCustomRoutingEndpointGroups(region string) error {
 	return nil
 }
 
-func sweepCustomRoutingListeners(region string) error {
-	ctx := sweep.Context(region)
+func sweepCustomRoutingListeners(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -353,6 +352,7 @@ func sweepCustomRoutingListeners(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Global Accelerator Custom Routing Accelerator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,32 +4,31 @@
 package guardduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_guardduty_detector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_detector", &sweep.Sweeper{
 		Name:         "aws_guardduty_detector",
 		F:            sweepDetectors,
 		Dependencies: []string{"aws_guardduty_publishing_destination"},
 	})
 
-	resource.AddTestSweepers("aws_guardduty_publishing_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_guardduty_publishing_destination", &sweep.Sweeper{
 		Name: "aws_guardduty_publishing_destination",
 		F:    sweepPublishingDestinations,
 	})
 }
 
-func sweepDetectors(region string) error {
-	ctx := sweep.Context(region)
+func sweepDetectors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -46,6 +45,7 @@ func sweepDetectors(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping GuardDuty Detector sweep for %s: %s", region, err)
 			return nil
 		}
@@ -76,8 +76,7 @@ func sweepDetectors(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPublishingDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepPublishingDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -111,6 +110,7 @@ func sweepPublishingDestinations(region string) error {
 				page, err := pages.NextPage(ctx)
 
 				if awsv2.SkipSweepError(err) {
+					sweep.ReportSkipped(ctx, err)
 					log.Printf("[WARN] Skipping GuardDuty Publishing Destination sweep for %s: %s", region, err)
 					return nil
 				}
@@ -31,6 +31,7 @@ import (
 func newPolicyResourceAsListResource() inttypes.ListResourceForSDK {
 	l := policyListResource{}
 	l.SetResourceSchema(resourcePolicy())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -28,6 +28,7 @@ import (
 func newRoleResourceAsListResource() inttypes.ListResourceForSDK {
 	l := roleListResource{}
 	l.SetResourceSchema(resourceRole())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -27,6 +27,7 @@ import (
 func newRolePolicyAttachmentResourceAsListResource() inttypes.ListResourceForSDK {
 	l := rolePolicyAttachmentListResource{}
 	l.SetResourceSchema(resourceRolePolicyAttachment())
+	l.SetReadOnInclude()
 
 	return &l
 }
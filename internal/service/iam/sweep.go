@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -28,7 +27,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &sweep.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,7 +41,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &sweep.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -54,7 +53,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &sweep.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_auditmanager_assessment",
@@ -92,14 +91,14 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_service_specific_credential", sweepServiceSpecificCredentials)
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &sweep.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &sweep.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -112,8 +111,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_iam_virtual_mfa_device", sweepVirtualMFADevice)
 }
 
-func sweepGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -128,6 +126,7 @@ func sweepGroups(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -354,8 +353,7 @@ func sweepServiceSpecificCredentials(ctx context.Context, client *conns.AWSClien
 	return sweepResources, nil
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -372,6 +370,7 @@ func sweepPolicies(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, err)
 			return nil
 		}
@@ -428,8 +427,7 @@ func (ps policySweeper) Delete(ctx context.Context, optFns ...tfresource.Options
 	return nil
 }
 
-func sweepRoles(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoles(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -441,6 +439,7 @@ func sweepRoles(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
 			return nil
 		}
@@ -509,8 +508,7 @@ func sweepSAMLProviders(ctx context.Context, client *conns.AWSClient) ([]sweep.S
 	return sweepResources, err
 }
 
-func sweepServerCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepServerCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -521,6 +519,7 @@ func sweepServerCertificates(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
 			return nil
 		}
@@ -588,8 +587,7 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 	return sweepResources, nil
 }
 
-func sweepUsers(region string) error {
-	ctx := sweep.Context(region)
+func sweepUsers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -608,6 +606,7 @@ func sweepUsers(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
 			return nil
 		}
@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/imagebuilder"
 	"github.com/aws/aws-sdk-go-v2/service/imagebuilder/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -22,37 +21,37 @@ import (
 func RegisterSweepers() {
 	awsv2.Register("aws_imagebuilder_component", sweepComponents)
 
-	resource.AddTestSweepers("aws_imagebuilder_distribution_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_distribution_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_distribution_configuration",
 		F:    sweepDistributionConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_pipeline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_pipeline", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_pipeline",
 		F:    sweepImagePipelines,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image_recipe",
 		F:    sweepImageRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_container_recipe", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_container_recipe", &sweep.Sweeper{
 		Name: "aws_imagebuilder_container_recipe",
 		F:    sweepContainerRecipes,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_image", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_image", &sweep.Sweeper{
 		Name: "aws_imagebuilder_image",
 		F:    sweepImages,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_infrastructure_configuration", &sweep.Sweeper{
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &sweep.Sweeper{
 		Name: "aws_imagebuilder_lifecycle_policy",
 		F:    sweepLifecyclePolicies,
 	})
@@ -99,8 +98,7 @@ func sweepComponents(ctx context.Context, client *conns.AWSClient) ([]sweep.Swee
 	return sweepResources, nil
 }
 
-func sweepDistributionConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributionConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -114,6 +112,7 @@ func sweepDistributionConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Distribution Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -140,8 +139,7 @@ func sweepDistributionConfigurations(region string) error {
 	return nil
 }
 
-func sweepImagePipelines(region string) error {
-	ctx := sweep.Context(region)
+func sweepImagePipelines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -155,6 +153,7 @@ func sweepImagePipelines(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Image Pipeline sweep for %s: %s", region, err)
 			return nil
 		}
@@ -181,8 +180,7 @@ func sweepImagePipelines(region string) error {
 	return nil
 }
 
-func sweepImageRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepImageRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -196,6 +194,7 @@ func sweepImageRecipes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Image Recipe sweep for %s: %s", region, err)
 			return nil
 		}
@@ -222,8 +221,7 @@ func sweepImageRecipes(region string) error {
 	return nil
 }
 
-func sweepContainerRecipes(region string) error {
-	ctx := sweep.Context(region)
+func sweepContainerRecipes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -237,6 +235,7 @@ func sweepContainerRecipes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Container Recipe sweep for %s: %s", region, err)
 			return nil
 		}
@@ -263,8 +262,7 @@ func sweepContainerRecipes(region string) error {
 	return nil
 }
 
-func sweepImages(region string) error {
-	ctx := sweep.Context(region)
+func sweepImages(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -278,6 +276,7 @@ func sweepImages(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Image sweep for %s: %s", region, err)
 			return nil
 		}
@@ -304,8 +303,7 @@ func sweepImages(region string) error {
 	return nil
 }
 
-func sweepInfrastructureConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepInfrastructureConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -319,6 +317,7 @@ func sweepInfrastructureConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Infrastructure Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -345,8 +344,7 @@ func sweepInfrastructureConfigurations(region string) error {
 	return nil
 }
 
-func sweepLifecyclePolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepLifecyclePolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -360,6 +358,7 @@ func sweepLifecyclePolicies(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Image Builder Lifecycle Policy sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,14 +14,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iot"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iot/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iot_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_certificate", &sweep.Sweeper{
 		Name: "aws_iot_certificate",
 		F:    sweepCertificates,
 		Dependencies: []string{
@@ -29,12 +29,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy_attachment", &sweep.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_policy", &sweep.Sweeper{
 		Name: "aws_iot_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -42,17 +42,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_role_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_role_alias", &sweep.Sweeper{
 		Name: "aws_iot_role_alias",
 		F:    sweepRoleAliases,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_principal_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_principal_attachment", &sweep.Sweeper{
 		Name: "aws_iot_thing_principal_attachment",
 		F:    sweepThingPrincipalAttachments,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing", &sweep.Sweeper{
 		Name: "aws_iot_thing",
 		F:    sweepThings,
 		Dependencies: []string{
@@ -60,12 +60,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_group", &sweep.Sweeper{
 		Name: "aws_iot_thing_group",
 		F:    sweepThingGroups,
 	})
 
-	resource.AddTestSweepers("aws_iot_thing_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_thing_type", &sweep.Sweeper{
 		Name: "aws_iot_thing_type",
 		F:    sweepThingTypes,
 		Dependencies: []string{
@@ -73,7 +73,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule", &sweep.Sweeper{
 		Name: "aws_iot_topic_rule",
 		F:    sweepTopicRules,
 		Dependencies: []string{
@@ -81,12 +81,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_topic_rule_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_topic_rule_destination", &sweep.Sweeper{
 		Name: "aws_iot_topic_rule_destination",
 		F:    sweepTopicRuleDestinations,
 	})
 
-	resource.AddTestSweepers("aws_iot_authorizer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_authorizer", &sweep.Sweeper{
 		Name: "aws_iot_authorizer",
 		F:    sweepAuthorizers,
 		Dependencies: []string{
@@ -94,12 +94,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_domain_configuration", &sweep.Sweeper{
 		Name: "aws_iot_domain_configuration",
 		F:    sweepDomainConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_iot_ca_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iot_ca_certificate", &sweep.Sweeper{
 		Name: "aws_iot_ca_certificate",
 		F:    sweepCACertificates,
 		Dependencies: []string{
@@ -108,8 +108,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepCertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -123,6 +122,7 @@ func sweepCertificates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Certificate sweep for %s: %s", region, err)
 			return nil
 		}
@@ -150,8 +150,7 @@ func sweepCertificates(region string) error {
 	return nil
 }
 
-func sweepPolicyAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicyAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -166,6 +165,7 @@ func sweepPolicyAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Policy Attachment sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -214,8 +214,7 @@ func sweepPolicyAttachments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -229,6 +228,7 @@ func sweepPolicies(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Policy sweep for %s: %s", region, err)
 			return nil
 		}
@@ -255,8 +255,7 @@ func sweepPolicies(region string) error {
 	return nil
 }
 
-func sweepRoleAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepRoleAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -270,6 +269,7 @@ func sweepRoleAliases(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Role Alias sweep for %s: %s", region, err)
 			return nil
 		}
@@ -296,8 +296,7 @@ func sweepRoleAliases(region string) error {
 	return nil
 }
 
-func sweepThingPrincipalAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingPrincipalAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -312,6 +311,7 @@ func sweepThingPrincipalAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Thing Principal Attachment sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -360,8 +360,7 @@ func sweepThingPrincipalAttachments(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepThings(region string) error {
-	ctx := sweep.Context(region)
+func sweepThings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -375,6 +374,7 @@ func sweepThings(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Thing sweep for %s: %s", region, err)
 			return nil
 		}
@@ -401,8 +401,7 @@ func sweepThings(region string) error {
 	return nil
 }
 
-func sweepThingTypes(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingTypes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -416,6 +415,7 @@ func sweepThingTypes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Thing Type sweep for %s: %s", region, err)
 			return nil
 		}
@@ -442,8 +442,7 @@ func sweepThingTypes(region string) error {
 	return nil
 }
 
-func sweepTopicRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -457,6 +456,7 @@ func sweepTopicRules(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Topic Rule sweep for %s: %s", region, err)
 			return nil
 		}
@@ -483,8 +483,7 @@ func sweepTopicRules(region string) error {
 	return nil
 }
 
-func sweepThingGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepThingGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -498,6 +497,7 @@ func sweepThingGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Thing Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -524,8 +524,7 @@ func sweepThingGroups(region string) error {
 	return nil
 }
 
-func sweepTopicRuleDestinations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicRuleDestinations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -539,6 +538,7 @@ func sweepTopicRuleDestinations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Topic Rule Destination sweep for %s: %s", region, err)
 			return nil
 		}
@@ -565,8 +565,7 @@ func sweepTopicRuleDestinations(region string) error {
 	return nil
 }
 
-func sweepAuthorizers(region string) error {
-	ctx := sweep.Context(region)
+func sweepAuthorizers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -580,6 +579,7 @@ func sweepAuthorizers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Authorizer sweep for %s: %s", region, err)
 			return nil
 		}
@@ -607,8 +607,7 @@ func sweepAuthorizers(region string) error {
 	return nil
 }
 
-func sweepDomainConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomainConfigurations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -622,6 +621,7 @@ func sweepDomainConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT Domain Configuration sweep for %s: %s", region, err)
 			return nil
 		}
@@ -671,8 +671,7 @@ func sweepDomainConfigurations(region string) error {
 	return nil
 }
 
-func sweepCACertificates(region string) error {
-	ctx := sweep.Context(region)
+func sweepCACertificates(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -686,6 +685,7 @@ func sweepCACertificates(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping IoT CA Certificate sweep for %s: %s", region, err)
 			return nil
 		}
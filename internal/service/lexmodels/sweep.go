@@ -4,44 +4,43 @@
 package lexmodels
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelbuildingservice"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lex_bot_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot_alias", &sweep.Sweeper{
 		Name: "aws_lex_bot_alias",
 		F:    sweepBotAliases,
 	})
 
-	resource.AddTestSweepers("aws_lex_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_bot", &sweep.Sweeper{
 		Name:         "aws_lex_bot",
 		F:            sweepBots,
 		Dependencies: []string{"aws_lex_bot_alias"},
 	})
 
-	resource.AddTestSweepers("aws_lex_intent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_intent", &sweep.Sweeper{
 		Name:         "aws_lex_intent",
 		F:            sweepIntents,
 		Dependencies: []string{"aws_lex_bot"},
 	})
 
-	resource.AddTestSweepers("aws_lex_slot_type", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lex_slot_type", &sweep.Sweeper{
 		Name:         "aws_lex_slot_type",
 		F:            sweepSlotTypes,
 		Dependencies: []string{"aws_lex_intent"},
 	})
 }
 
-func sweepBotAliases(region string) error {
-	ctx := sweep.Context(region)
+func sweepBotAliases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -55,6 +54,7 @@ func sweepBotAliases(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lex Bot Alias sweep for %s: %s", region, err)
 			return nil
 		}
@@ -74,6 +74,7 @@ func sweepBotAliases(region string) error {
 				page, err := pages.NextPage(ctx)
 
 				if awsv2.SkipSweepError(err) {
+					sweep.ReportSkipped(ctx, err)
 					log.Printf("[WARN] Skipping Lex Bot Alias sweep for %s: %s", region, err)
 					return nil
 				}
@@ -105,8 +106,7 @@ func sweepBotAliases(region string) error {
 	return nil
 }
 
-func sweepBots(region string) error {
-	ctx := sweep.Context(region)
+func sweepBots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -120,6 +120,7 @@ func sweepBots(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lex Bot sweep for %s: %s", region, err)
 			return nil
 		}
@@ -146,8 +147,7 @@ func sweepBots(region string) error {
 	return nil
 }
 
-func sweepIntents(region string) error {
-	ctx := sweep.Context(region)
+func sweepIntents(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -161,6 +161,7 @@ func sweepIntents(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lex Intent sweep for %s: %s", region, err)
 			return nil
 		}
@@ -187,8 +188,7 @@ func sweepIntents(region string) error {
 	return nil
 }
 
-func sweepSlotTypes(region string) error {
-	ctx := sweep.Context(region)
+func sweepSlotTypes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -202,6 +202,7 @@ func sweepSlotTypes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lex Slot Type sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,12 +4,12 @@
 package lexv2models
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,14 +17,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lexv2models_bot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lexv2models_bot", &sweep.Sweeper{
 		Name: "aws_lexv2models_bot",
 		F:    sweepBots,
 	})
 }
 
-func sweepBots(region string) error {
-	ctx := sweep.Context(region)
+func sweepBots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -38,6 +37,7 @@ func sweepBots(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lex V2 Models Bot sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,61 +4,60 @@
 package lightsail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lightsail_container_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_container_service", &sweep.Sweeper{
 		Name: "aws_lightsail_container_service",
 		F:    sweepContainerServices,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_database", &sweep.Sweeper{
 		Name: "aws_lightsail_database",
 		F:    sweepDatabases,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_disk", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_disk", &sweep.Sweeper{
 		Name: "aws_lightsail_disk",
 		F:    sweepDisks,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_distribution", &sweep.Sweeper{
 		Name: "aws_lightsail_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_domain", &sweep.Sweeper{
 		Name: "aws_lightsail_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &sweep.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_lb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_lb", &sweep.Sweeper{
 		Name: "aws_lightsail_lb",
 		F:    sweepLoadBalancers,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &sweep.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
 }
 
-func sweepContainerServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepContainerServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -71,6 +70,7 @@ func sweepContainerServices(region string) error {
 	output, err := conn.GetContainerServices(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Container Service sweep for %s: %s", region, err)
 		return nil
 	}
@@ -94,8 +94,7 @@ func sweepContainerServices(region string) error {
 	return nil
 }
 
-func sweepDatabases(region string) error {
-	ctx := sweep.Context(region)
+func sweepDatabases(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -123,6 +122,7 @@ func sweepDatabases(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Databases sweep for %s: %s", region, err)
 		return nil
 	}
@@ -138,8 +138,7 @@ func sweepDatabases(region string) error {
 	return nil
 }
 
-func sweepDisks(region string) error {
-	ctx := sweep.Context(region)
+func sweepDisks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -166,6 +165,7 @@ func sweepDisks(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Disks sweep for %s: %s", region, err)
 		return nil
 	}
@@ -181,8 +181,7 @@ func sweepDisks(region string) error {
 	return nil
 }
 
-func sweepDistributions(region string) error {
-	ctx := sweep.Context(region)
+func sweepDistributions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -209,6 +208,7 @@ func sweepDistributions(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Distributions sweep for %s: %s", region, err)
 		return nil
 	}
@@ -224,8 +224,7 @@ func sweepDistributions(region string) error {
 	return nil
 }
 
-func sweepDomains(region string) error {
-	ctx := sweep.Context(region)
+func sweepDomains(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -252,6 +251,7 @@ func sweepDomains(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Domain sweep for %s: %s", region, err)
 		return nil
 	}
@@ -267,8 +267,7 @@ func sweepDomains(region string) error {
 	return nil
 }
 
-func sweepInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -282,6 +281,7 @@ func sweepInstances(region string) error {
 		output, err := conn.GetInstances(ctx, input)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -316,8 +316,7 @@ func sweepInstances(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLoadBalancers(region string) error {
-	ctx := sweep.Context(region)
+func sweepLoadBalancers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -344,6 +343,7 @@ func sweepLoadBalancers(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Lightsail Load Balanders sweep for %s: %s", region, err)
 		return nil
 	}
@@ -359,8 +359,7 @@ func sweepLoadBalancers(region string) error {
 	return nil
 }
 
-func sweepStaticIPs(region string) error {
-	ctx := sweep.Context(region)
+func sweepStaticIPs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -373,6 +372,7 @@ func sweepStaticIPs(region string) error {
 		output, err := conn.GetStaticIps(ctx, input)
 		if err != nil {
 			if awsv2.SkipSweepError(err) {
+				sweep.ReportSkipped(ctx, err)
 				log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, err)
 				return nil
 			}
@@ -4,50 +4,49 @@
 package location
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/location"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_location_geofence_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_geofence_collection", &sweep.Sweeper{
 		Name: "aws_location_geofence_collection",
 		F:    sweepGeofenceCollections,
 	})
 
-	resource.AddTestSweepers("aws_location_map", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_map", &sweep.Sweeper{
 		Name: "aws_location_map",
 		F:    sweepMaps,
 	})
 
-	resource.AddTestSweepers("aws_location_place_index", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_place_index", &sweep.Sweeper{
 		Name: "aws_location_place_index",
 		F:    sweepPlaceIndexes,
 	})
 
-	resource.AddTestSweepers("aws_location_route_calculator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_route_calculator", &sweep.Sweeper{
 		Name: "aws_location_route_calculator",
 		F:    sweepRouteCalculators,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker", &sweep.Sweeper{
 		Name: "aws_location_tracker",
 		F:    sweepTrackers,
 	})
 
-	resource.AddTestSweepers("aws_location_tracker_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_location_tracker_association", &sweep.Sweeper{
 		Name: "aws_location_tracker_association",
 		F:    sweepTrackerAssociations,
 	})
 }
 
-func sweepGeofenceCollections(region string) error {
-	ctx := sweep.Context(region)
+func sweepGeofenceCollections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -63,6 +62,7 @@ func sweepGeofenceCollections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Geofence Collection sweep for %s: %s", region, err)
 			return nil
 		}
@@ -89,8 +89,7 @@ func sweepGeofenceCollections(region string) error {
 	return nil
 }
 
-func sweepMaps(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaps(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -107,6 +106,7 @@ func sweepMaps(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Map sweep for %s: %s", region, err)
 			return nil
 		}
@@ -133,8 +133,7 @@ func sweepMaps(region string) error {
 	return nil
 }
 
-func sweepPlaceIndexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlaceIndexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -151,6 +150,7 @@ func sweepPlaceIndexes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Place Index sweep for %s: %s", region, err)
 			return nil
 		}
@@ -177,8 +177,7 @@ func sweepPlaceIndexes(region string) error {
 	return nil
 }
 
-func sweepRouteCalculators(region string) error {
-	ctx := sweep.Context(region)
+func sweepRouteCalculators(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -195,6 +194,7 @@ func sweepRouteCalculators(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Route Calculator sweep for %s: %s", region, err)
 			return nil
 		}
@@ -221,8 +221,7 @@ func sweepRouteCalculators(region string) error {
 	return nil
 }
 
-func sweepTrackers(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -239,6 +238,7 @@ func sweepTrackers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Tracker sweep for %s: %s", region, err)
 			return nil
 		}
@@ -265,8 +265,7 @@ func sweepTrackers(region string) error {
 	return nil
 }
 
-func sweepTrackerAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrackerAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -283,6 +282,7 @@ func sweepTrackerAssociations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Location Service Tracker Association sweep for %s: %s", region, err)
 			return nil
 		}
@@ -302,6 +302,7 @@ func sweepTrackerAssociations(region string) error {
 				consumerPage, err := consumerPages.NextPage(ctx)
 
 				if awsv2.SkipSweepError(err) {
+					sweep.ReportSkipped(ctx, err)
 					log.Printf("[WARN] Skipping Location Service Tracker Association sweep for %s: %s", region, err)
 					return nil
 				}
@@ -41,6 +41,7 @@ var (
 	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
 	FindTransformerByLogGroupIdentifier                    = findTransformerByLogGroupIdentifier
 
+	LogGroupListInput                      = logGroupListInput
 	TrimLogGroupARNWildcardSuffix          = trimLogGroupARNWildcardSuffix
 	ValidLogGroupName                      = validLogGroupName
 	ValidLogGroupNamePrefix                = validLogGroupNamePrefix
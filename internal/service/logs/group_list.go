@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -22,6 +23,7 @@ import (
 func newLogGroupResourceAsListResource() inttypes.ListResourceForSDK {
 	l := logGroupListResource{}
 	l.SetResourceSchema(resourceGroup())
+	l.SetReadOnInclude()
 
 	return &l
 }
@@ -46,9 +48,11 @@ func (l *logGroupListResource) List(ctx context.Context, request list.ListReques
 		}
 	}
 
+	// The name prefix filter must be marked as applied before the results are streamed.
+	input := logGroupListInput(ctx)
+
 	stream.Results = func(yield func(list.ListResult) bool) {
 		result := request.NewListResult(ctx)
-		var input cloudwatchlogs.DescribeLogGroupsInput
 		for output, err := range listLogGroups(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.LogGroup]()) {
 			if err != nil {
 				result = fwdiag.NewListResultErrorDiagnostic(err)
@@ -75,6 +79,18 @@ func (l *logGroupListResource) List(ctx context.Context, request list.ListReques
 	}
 }
 
+// logGroupListInput returns the input used to list log groups, applying any list query name prefix filter.
+func logGroupListInput(ctx context.Context) cloudwatchlogs.DescribeLogGroupsInput {
+	var input cloudwatchlogs.DescribeLogGroupsInput
+
+	if query, ok := listresource.QueryFromContext(ctx); ok && query.NamePrefix != "" {
+		input.LogGroupNamePrefix = aws.String(query.NamePrefix)
+		query.SetNamePrefixApplied()
+	}
+
+	return input
+}
+
 func listLogGroups(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeLogGroupsInput, filter tfslices.Predicate[*awstypes.LogGroup]) iter.Seq2[awstypes.LogGroup, error] {
 	return func(yield func(awstypes.LogGroup, error) bool) {
 		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
@@ -6,6 +6,7 @@ package logs_test
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -144,3 +147,43 @@ func TestAccLogsLogGroup_List_regionOverride(t *testing.T) {
 		},
 	})
 }
+
+func TestLogGroupListInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query              *listresource.Query
+		expectedNamePrefix *string
+	}{
+		"no query": {},
+		"no name prefix": {
+			query: &listresource.Query{},
+		},
+		"name prefix": {
+			query:              &listresource.Query{NamePrefix: "tf-acc-test"},
+			expectedNamePrefix: aws.String("tf-acc-test"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			if testCase.query != nil {
+				ctx = listresource.NewQueryContext(ctx, testCase.query)
+			}
+
+			input := tflogs.LogGroupListInput(ctx)
+
+			if got, want := aws.ToString(input.LogGroupNamePrefix), aws.ToString(testCase.expectedNamePrefix); got != want {
+				t.Errorf("LogGroupNamePrefix: got %q, want %q", got, want)
+			}
+
+			// The filter is applied before results are streamed, so List is not run again with the full resource state.
+			if testCase.query != nil && testCase.query.HasUnappliedFilters() {
+				t.Error("expected all filters to be applied")
+			}
+		})
+	}
+}
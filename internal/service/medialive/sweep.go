@@ -4,28 +4,28 @@
 package medialive
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/medialive"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_medialive_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_channel", &sweep.Sweeper{
 		Name: "aws_medialive_channel",
 		F:    sweepChannels,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input", &sweep.Sweeper{
 		Name: "aws_medialive_input",
 		F:    sweepInputs,
 	})
 
-	resource.AddTestSweepers("aws_medialive_input_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_input_security_group", &sweep.Sweeper{
 		Name: "aws_medialive_input_security_group",
 		F:    sweepInputSecurityGroups,
 		Dependencies: []string{
@@ -33,14 +33,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_medialive_multiplex", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_medialive_multiplex", &sweep.Sweeper{
 		Name: "aws_medialive_multiplex",
 		F:    sweepMultiplexes,
 	})
 }
 
-func sweepChannels(region string) error {
-	ctx := sweep.Context(region)
+func sweepChannels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -56,6 +55,7 @@ func sweepChannels(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping MediaLive Channels sweep for %s: %s", region, err)
 			return nil
 		}
@@ -83,8 +83,7 @@ func sweepChannels(region string) error {
 	return nil
 }
 
-func sweepInputs(region string) error {
-	ctx := sweep.Context(region)
+func sweepInputs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -100,6 +99,7 @@ func sweepInputs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping MediaLive Inputs sweep for %s: %s", region, err)
 			return nil
 		}
@@ -127,8 +127,7 @@ func sweepInputs(region string) error {
 	return nil
 }
 
-func sweepInputSecurityGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepInputSecurityGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -144,6 +143,7 @@ func sweepInputSecurityGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping MediaLive Input Security Groups sweep for %s: %s", region, err)
 			return nil
 		}
@@ -171,8 +171,7 @@ func sweepInputSecurityGroups(region string) error {
 	return nil
 }
 
-func sweepMultiplexes(region string) error {
-	ctx := sweep.Context(region)
+func sweepMultiplexes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -188,6 +187,7 @@ func sweepMultiplexes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping MediaLive Multiplexes sweep for %s: %s", region, err)
 			return nil
 		}
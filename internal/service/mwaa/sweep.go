@@ -4,25 +4,24 @@
 package mwaa
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mwaa_environment", &sweep.Sweeper{
 		Name: "aws_mwaa_environment",
 		F:    sweepEnvironment,
 	})
 }
 
-func sweepEnvironment(region string) error {
-	ctx := sweep.Context(region)
+func sweepEnvironment(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -36,6 +35,7 @@ func sweepEnvironment(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InternalFailure") {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,13 +4,13 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/neptune"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_neptune_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster", &sweep.Sweeper{
 		Name: "aws_neptune_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -26,12 +26,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_instance", &sweep.Sweeper{
 		Name: "aws_neptune_cluster_instance",
 		F:    sweepClusterInstances,
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_parameter_group", &sweep.Sweeper{
 		Name: "aws_neptune_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -39,7 +39,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_cluster_snapshot", &sweep.Sweeper{
 		Name: "aws_neptune_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -47,12 +47,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_event_subscription", &sweep.Sweeper{
 		Name: "aws_neptune_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_neptune_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_global_cluster", &sweep.Sweeper{
 		Name: "aws_neptune_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -60,7 +60,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_parameter_group", &sweep.Sweeper{
 		Name: "aws_neptune_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -68,7 +68,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_neptune_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_neptune_subnet_group", &sweep.Sweeper{
 		Name: "aws_neptune_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -77,8 +77,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepEventSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepEventSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -92,6 +91,7 @@ func sweepEventSubscriptions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Event Subscription sweep for %s: %s", region, err)
 			return nil
 		}
@@ -118,8 +118,7 @@ func sweepEventSubscriptions(region string) error {
 	return nil
 }
 
-func sweepClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -133,6 +132,7 @@ func sweepClusters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Cluster sweep for %s: %s", region, err)
 			return nil
 		}
@@ -177,8 +177,7 @@ func sweepClusters(region string) error {
 	return nil
 }
 
-func sweepClusterSnapshots(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusterSnapshots(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -192,6 +191,7 @@ func sweepClusterSnapshots(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Cluster Snapshot sweep for %s: %s", region, err)
 			return nil
 		}
@@ -218,8 +218,7 @@ func sweepClusterSnapshots(region string) error {
 	return nil
 }
 
-func sweepClusterParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusterParameterGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -233,6 +232,7 @@ func sweepClusterParameterGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Cluster Parameter Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -266,8 +266,7 @@ func sweepClusterParameterGroups(region string) error {
 	return nil
 }
 
-func sweepClusterInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepClusterInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -281,6 +280,7 @@ func sweepClusterInstances(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Cluster Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -320,8 +320,7 @@ func sweepClusterInstances(region string) error {
 	return nil
 }
 
-func sweepGlobalClusters(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalClusters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -335,6 +334,7 @@ func sweepGlobalClusters(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Global Cluster sweep for %s: %s", region, err)
 			return nil
 		}
@@ -361,8 +361,7 @@ func sweepGlobalClusters(region string) error {
 	return nil
 }
 
-func sweepParameterGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepParameterGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -376,6 +375,7 @@ func sweepParameterGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Parameter Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -409,8 +409,7 @@ func sweepParameterGroups(region string) error {
 	return nil
 }
 
-func sweepSubnetGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepSubnetGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -424,6 +423,7 @@ func sweepSubnetGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Neptune Subnet Group sweep for %s: %s", region, err)
 			return nil
 		}
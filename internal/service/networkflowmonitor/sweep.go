@@ -4,12 +4,12 @@
 package networkflowmonitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkflowmonitor"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,19 +17,18 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_networkflowmonitor_monitor", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkflowmonitor_monitor", &sweep.Sweeper{
 		Name: "aws_networkflowmonitor_monitor",
 		F:    sweepMonitors,
 	})
 
-	resource.AddTestSweepers("aws_networkflowmonitor_scope", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkflowmonitor_scope", &sweep.Sweeper{
 		Name: "aws_networkflowmonitor_scope",
 		F:    sweepScopes,
 	})
 }
 
-func sweepMonitors(region string) error {
-	ctx := sweep.Context(region)
+func sweepMonitors(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -43,6 +42,7 @@ func sweepMonitors(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Flow Monitor Monitor sweep for %s: %s", region, err)
 			return nil
 		}
@@ -70,8 +70,7 @@ func sweepMonitors(region string) error {
 
 	return nil
 }
-func sweepScopes(region string) error {
-	ctx := sweep.Context(region)
+func sweepScopes(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
@@ -85,6 +84,7 @@ func sweepScopes(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Flow Monitor Scope sweep for %s: %s", region, err)
 			return nil
 		}
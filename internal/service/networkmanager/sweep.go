@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/networkmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkmanager/types"
 	multierror "github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -21,7 +20,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_global_network",
 		F:    sweepGlobalNetworks,
 		Dependencies: []string{
@@ -30,7 +29,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_core_network", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_core_network", &sweep.Sweeper{
 		Name: "aws_networkmanager_core_network",
 		F:    sweepCoreNetworks,
 		Dependencies: []string{
@@ -42,7 +41,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connect_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connect_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_connect_attachment",
 		F:    sweepConnectAttachments,
 		Dependencies: []string{
@@ -50,17 +49,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_dx_gateway_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_dx_gateway_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_dx_gateway_attachment",
 		F:    sweepDirectConnectGatewayAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site_to_site_vpn_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_site_to_site_vpn_attachment",
 		F:    sweepSiteToSiteVPNAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_peering", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_peering",
 		F:    sweepTransitGatewayPeerings,
 		Dependencies: []string{
@@ -68,17 +67,17 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_route_table_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_transit_gateway_route_table_attachment",
 		F:    sweepTransitGatewayRouteTableAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_vpc_attachment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_vpc_attachment", &sweep.Sweeper{
 		Name: "aws_networkmanager_vpc_attachment",
 		F:    sweepVPCAttachments,
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_site", &sweep.Sweeper{
 		Name: "aws_networkmanager_site",
 		F:    sweepSites,
 		Dependencies: []string{
@@ -87,7 +86,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_device", &sweep.Sweeper{
 		Name: "aws_networkmanager_device",
 		F:    sweepDevices,
 		Dependencies: []string{
@@ -95,7 +94,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link", &sweep.Sweeper{
 		Name: "aws_networkmanager_link",
 		F:    sweepLinks,
 		Dependencies: []string{
@@ -103,7 +102,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &sweep.Sweeper{
 		Name: "aws_networkmanager_link_association",
 		F:    sweepLinkAssociations,
 		Dependencies: []string{
@@ -111,7 +110,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkmanager_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkmanager_connection", &sweep.Sweeper{
 		Name: "aws_networkmanager_connection",
 		F:    sweepConnections,
 	})
@@ -119,8 +118,7 @@ func RegisterSweepers() {
 	awsv2.Register("aws_networkmanager_connect_peer", sweepConnectPeers)
 }
 
-func sweepGlobalNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepGlobalNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -134,6 +132,7 @@ func sweepGlobalNetworks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Global Network sweep for %s: %s", region, err)
 			return nil
 		}
@@ -160,8 +159,7 @@ func sweepGlobalNetworks(region string) error {
 	return nil
 }
 
-func sweepCoreNetworks(region string) error {
-	ctx := sweep.Context(region)
+func sweepCoreNetworks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -175,6 +173,7 @@ func sweepCoreNetworks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Core Network sweep for %s: %s", region, err)
 			return nil
 		}
@@ -201,8 +200,7 @@ func sweepCoreNetworks(region string) error {
 	return nil
 }
 
-func sweepConnectAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnectAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -218,6 +216,7 @@ func sweepConnectAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Connect Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -244,8 +243,7 @@ func sweepConnectAttachments(region string) error {
 	return nil
 }
 
-func sweepDirectConnectGatewayAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectConnectGatewayAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -261,6 +259,7 @@ func sweepDirectConnectGatewayAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Direct Connect Gateway Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -290,8 +289,7 @@ func sweepDirectConnectGatewayAttachments(region string) error {
 	return nil
 }
 
-func sweepSiteToSiteVPNAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepSiteToSiteVPNAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -307,6 +305,7 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Site To Site VPN Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -333,8 +332,7 @@ func sweepSiteToSiteVPNAttachments(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayPeerings(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayPeerings(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -350,6 +348,7 @@ func sweepTransitGatewayPeerings(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Transit Gateway Peering sweep for %s: %s", region, err)
 			return nil
 		}
@@ -376,8 +375,7 @@ func sweepTransitGatewayPeerings(region string) error {
 	return nil
 }
 
-func sweepTransitGatewayRouteTableAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepTransitGatewayRouteTableAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -393,6 +391,7 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Transit Gateway Route Table Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -419,8 +418,7 @@ func sweepTransitGatewayRouteTableAttachments(region string) error {
 	return nil
 }
 
-func sweepVPCAttachments(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCAttachments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -436,6 +434,7 @@ func sweepVPCAttachments(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager VPC Attachment sweep for %s: %s", region, err)
 			return nil
 		}
@@ -462,8 +461,7 @@ func sweepVPCAttachments(region string) error {
 	return nil
 }
 
-func sweepSites(region string) error {
-	ctx := sweep.Context(region)
+func sweepSites(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -478,6 +476,7 @@ func sweepSites(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Site sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -524,8 +523,7 @@ func sweepSites(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepDevices(region string) error {
-	ctx := sweep.Context(region)
+func sweepDevices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -540,6 +538,7 @@ func sweepDevices(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Device sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -586,8 +585,7 @@ func sweepDevices(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinks(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -602,6 +600,7 @@ func sweepLinks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Link sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil()
 		}
@@ -648,8 +647,7 @@ func sweepLinks(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepLinkAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepLinkAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -664,6 +662,7 @@ func sweepLinkAssociations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Link Association sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -709,8 +708,7 @@ func sweepLinkAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepConnections(region string) error {
-	ctx := sweep.Context(region)
+func sweepConnections(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -725,6 +723,7 @@ func sweepConnections(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Network Manager Connection sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
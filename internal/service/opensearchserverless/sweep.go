@@ -4,6 +4,7 @@
 package opensearchserverless
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -20,30 +20,29 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_opensearchserverless_access_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_access_policy", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_access_policy",
 		F:    sweepAccessPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_collection", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_collection",
 		F:    sweepCollections,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_config", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_security_config",
 		F:    sweepSecurityConfigs,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_security_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_security_policy", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_security_policy",
 		F:    sweepSecurityPolicies,
 	})
-	resource.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &sweep.Sweeper{
 		Name: "aws_opensearchserverless_vpc_endpoint",
 		F:    sweepVPCEndpoints,
 	})
 }
 
-func sweepAccessPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPolicies(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID || region == endpoints.UsGovEast1RegionID {
 		log.Printf("[WARN] Skipping OpenSearch Serverless Access Policy sweep for region: %s", region)
 		return nil
@@ -63,6 +62,7 @@ func sweepAccessPolicies(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless Access Policies sweep for %s: %s", region, err)
 			return nil
 		}
@@ -89,8 +89,7 @@ func sweepAccessPolicies(region string) error {
 	return nil
 }
 
-func sweepCollections(region string) error {
-	ctx := sweep.Context(region)
+func sweepCollections(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID || region == endpoints.UsGovEast1RegionID {
 		log.Printf("[WARN] Skipping OpenSearch Serverless Collection sweep for region: %s", region)
 		return nil
@@ -108,6 +107,7 @@ func sweepCollections(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless Collections sweep for %s: %s", region, err)
 			return nil
 		}
@@ -132,8 +132,7 @@ func sweepCollections(region string) error {
 	return nil
 }
 
-func sweepSecurityConfigs(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityConfigs(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID || region == endpoints.UsGovEast1RegionID {
 		log.Printf("[WARN] Skipping OpenSearch Serverless Security Config sweep for region: %s", region)
 		return nil
@@ -153,6 +152,7 @@ func sweepSecurityConfigs(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless Security Configs sweep for %s: %s", region, err)
 			return nil
 		}
@@ -177,8 +177,7 @@ func sweepSecurityConfigs(region string) error {
 	return nil
 }
 
-func sweepSecurityPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepSecurityPolicies(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID || region == endpoints.UsGovEast1RegionID {
 		log.Printf("[WARN] Skipping OpenSearch Serverless Security Policy sweep for region: %s", region)
 		return nil
@@ -198,6 +197,7 @@ func sweepSecurityPolicies(region string) error {
 	for pagesEncryption.HasMorePages() {
 		page, err := pagesEncryption.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless Security Policies sweep for %s: %s", region, err)
 			return nil
 		}
@@ -225,6 +225,7 @@ func sweepSecurityPolicies(region string) error {
 	for pagesNetwork.HasMorePages() {
 		page, err := pagesNetwork.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless Security Policies sweep for %s: %s", region, err)
 			return nil
 		}
@@ -251,8 +252,7 @@ func sweepSecurityPolicies(region string) error {
 	return nil
 }
 
-func sweepVPCEndpoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepVPCEndpoints(ctx context.Context, region string) error {
 	if region == endpoints.UsWest1RegionID || region == endpoints.UsGovEast1RegionID {
 		log.Printf("[WARN] Skipping OpenSearch Serverless Security Policy sweep for region: %s", region)
 		return nil
@@ -270,6 +270,7 @@ func sweepVPCEndpoints(region string) error {
 	for pages.HasMorePages() {
 		page, err := pages.NextPage(ctx)
 		if awsv2.SkipSweepError(err) || skipSweepErr(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping OpenSearch Serverless VPC Endpoints sweep for %s: %s", region, err)
 			return nil
 		}
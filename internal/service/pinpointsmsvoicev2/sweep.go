@@ -4,12 +4,12 @@
 package pinpointsmsvoicev2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pinpointsmsvoicev2"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,14 +17,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pinpointsmsvoicev2_phone_number", &sweep.Sweeper{
 		Name: "aws_pinpointsmsvoicev2_phone_number",
 		F:    sweepPhoneNumbers,
 	})
 }
 
-func sweepPhoneNumbers(region string) error {
-	ctx := sweep.Context(region)
+func sweepPhoneNumbers(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -38,6 +37,7 @@ func sweepPhoneNumbers(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping End User Messaging SMS Phone Number sweep for %s: %s", region, err)
 			return nil
 		}
@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -7410,3 +7411,24 @@ resource "aws_kms_key" "test" {
 }
 `, rName, tfrds.ClusterEngineMySQL, databaseInsightsMode, performanceInsightsEnabled, performanceInsightsRetentionPeriod))
 }
+
+func TestWaitDBClusterAvailable_warning(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	t.Setenv(envvar.WaitWarningThreshold, "1ns")
+
+	ctx, cancel := context.WithCancel(retry.NewWarningsContext(t.Context()))
+	cancel()
+
+	conn := rds.New(rds.Options{Region: endpoints.UsWest2RegionID})
+	if _, err := tfrds.WaitDBClusterAvailable(ctx, conn, "test", false, time.Minute); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got %v", err)
+	}
+
+	// The wait is reported as long-running by the provider's state change wait.
+	warnings := retry.WarningsFromContext(ctx)
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d", len(warnings))
+	}
+	if want := "rds.waitDBClusterAvailable"; !strings.HasPrefix(warnings[0].Detail, want) {
+		t.Errorf("expected warning detail to start with %q, got %q", want, warnings[0].Detail)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotActionModel]
+}
+
+type createDBSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String `tfsdk:"db_snapshot_identifier"`
+	Tags                 tftags.Map   `tfsdk:"tags"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB instance and waits for the snapshot to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier for the DB snapshot. If not provided, an identifier is generated from the DB instance identifier and the current time",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				Description: "Tags to assign to the DB snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := config.DBInstanceIdentifier.ValueString()
+	snapshotID := config.DBSnapshotIdentifier.ValueString()
+	if snapshotID == "" {
+		snapshotID = fmt.Sprintf("%s-%s", instanceID, time.Now().UTC().Format("2006-01-02-15-04-05"))
+	}
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating snapshot %s of RDS DB instance %s...", snapshotID, instanceID),
+	})
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                 svcTags(tftags.New(ctx, config.Tags).IgnoreAWS()),
+	}
+	output, err := conn.CreateDBSnapshot(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+		)
+		return
+	}
+
+	snapshotARN := aws.ToString(output.DBSnapshot.DBSnapshotArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s (%s) started, waiting for it to become available...", snapshotID, snapshotARN),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBSnapshot], error) {
+		output, status, err := statusDBSnapshot(ctx, conn, snapshotID)()
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{}, err
+		}
+		if output == nil {
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{}, &retry.NotFoundError{Message: fmt.Sprintf("RDS DB snapshot %s not found", snapshotID)}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: actionwait.Status(status), Value: output.(*awstypes.DBSnapshot)}, nil
+	}, actionwait.Options[*awstypes.DBSnapshot]{
+		Timeout:            timeout,
+		Interval:           actionwait.FixedInterval(10 * time.Second),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{dbSnapshotAvailable},
+		TransitionalStates: []actionwait.Status{dbSnapshotCreating},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			var progress int32
+			if v, ok := fr.Value.(*awstypes.DBSnapshot); ok {
+				progress = aws.ToInt32(v.PercentProgress)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Snapshot %s (%s) is %s: %d%% complete", snapshotID, snapshotARN, fr.Status, progress),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Snapshot",
+				fmt.Sprintf("Snapshot %s (%s) of RDS DB instance %s did not become available within %s: %s", snapshotID, snapshotARN, instanceID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Snapshot Status",
+				fmt.Sprintf("Snapshot %s (%s) of RDS DB instance %s entered unexpected status: %s", snapshotID, snapshotARN, instanceID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Snapshot",
+				fmt.Sprintf("Error while waiting for snapshot %s (%s) of RDS DB instance %s: %s", snapshotID, snapshotARN, instanceID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s (%s) of RDS DB instance %s is available", snapshotID, snapshotARN, instanceID),
+	})
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_arn":        snapshotARN,
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					testAccCheckDBSnapshotCreatedByAction(ctx, rName, map[string]string{
+						"Name": rName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDBSnapshotCreatedByAction checks that the snapshot is available and has the expected tags, then deletes it.
+// Snapshots created by actions are not managed by Terraform.
+func testAccCheckDBSnapshotCreatedByAction(ctx context.Context, id string, tags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindDBSnapshotByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			input := rds.DeleteDBSnapshotInput{
+				DBSnapshotIdentifier: aws.String(id),
+			}
+			conn.DeleteDBSnapshot(ctx, &input) //nolint:errcheck // best-effort cleanup
+		}()
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS DB Snapshot (%s) status is %s, expected %s", id, got, want)
+		}
+
+		got := make(map[string]string)
+		for _, v := range output.TagList {
+			got[aws.ToString(v.Key)] = aws.ToString(v.Value)
+		}
+		for k, want := range tags {
+			if got[k] != want {
+				return fmt.Errorf("RDS DB Snapshot (%s) tag %s is %q, expected %q", id, k, got[k], want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateDBSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), fmt.Sprintf(`
+action "aws_rds_create_db_snapshot" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+    db_snapshot_identifier = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_db_instance.test.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_snapshot.test]
+    }
+  }
+}
+`, rName))
+}
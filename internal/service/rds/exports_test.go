@@ -68,6 +68,7 @@ var (
 	ProxyTargetParseResourceID                 = proxyTargetParseResourceID
 	WaitBlueGreenDeploymentDeleted             = waitBlueGreenDeploymentDeleted
 	WaitBlueGreenDeploymentAvailable           = waitBlueGreenDeploymentAvailable
+	WaitDBClusterAvailable                     = waitDBClusterAvailable
 	WaitDBInstanceAvailable                    = waitDBInstanceAvailable
 	WaitDBInstanceDeleted                      = waitDBInstanceDeleted
 
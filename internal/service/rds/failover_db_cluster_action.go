@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster, promoting a reader DB instance to be the writer, and waits for the DB cluster to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to promote to the writer. If not provided, RDS chooses a reader DB instance",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB cluster to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := config.DBClusterIdentifier.ValueString()
+	target := config.TargetDBInstanceIdentifier.ValueString()
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         id,
+		"target_db_instance_identifier": target,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	cluster, err := findDBClusterByID(ctx, conn, id)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Cluster Not Found",
+			fmt.Sprintf("RDS DB cluster %s was not found", id),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe RDS DB cluster %s: %s", id, err),
+		)
+		return
+	}
+
+	if status := aws.ToString(cluster.Status); status != clusterStatusAvailable {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("RDS DB cluster %s is in status '%s' and cannot be failed over. DB cluster must be in 'available' status.", id, status),
+		)
+		return
+	}
+
+	writer := dbClusterWriter(cluster)
+	if target != "" && target == writer {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("DB instance %s is already the writer of RDS DB cluster %s", target, id),
+		)
+		return
+	}
+
+	// Without a reader DB instance to promote, the writer can never change.
+	if target == "" && !dbClusterHasReader(cluster) {
+		resp.Diagnostics.AddError(
+			"Cannot Fail Over DB Cluster",
+			fmt.Sprintf("RDS DB cluster %s has no reader DB instance to promote to the writer", id),
+		)
+		return
+	}
+
+	message := fmt.Sprintf("Failing over RDS DB cluster %s from writer %s", id, writer)
+	if target != "" {
+		message += " to " + target
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message + "..."})
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+	if target != "" {
+		input.TargetDBInstanceIdentifier = aws.String(target)
+	}
+	if _, err := conn.FailoverDBCluster(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", id, err),
+		)
+		return
+	}
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBCluster], error) {
+		output, status, err := statusDBCluster(conn, id, false)(ctx)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, err
+		}
+		if output == nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, &retry.NotFoundError{Message: fmt.Sprintf("RDS DB cluster %s not found", id)}
+		}
+
+		cluster := output.(*awstypes.DBCluster)
+
+		// The DB cluster may still report itself as available before the failover starts.
+		// A failover is only awaited when there is a DB instance to promote, so the writer eventually changes.
+		if status == clusterStatusAvailable {
+			if v := dbClusterWriter(cluster); v == writer || (target != "" && v != target) {
+				status = clusterStatusFailingOver
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBCluster]{Status: actionwait.Status(status), Value: cluster}, nil
+	}, actionwait.Options[*awstypes.DBCluster]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(10 * time.Second),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterStatusAvailable},
+		TransitionalStates: []actionwait.Status{
+			clusterStatusFailingOver,
+			clusterStatusModifying,
+			clusterStatusRebooting,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			var writer string
+			if v, ok := fr.Value.(*awstypes.DBCluster); ok {
+				writer = dbClusterWriter(v)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("RDS DB cluster %s is %s, current writer: %s", id, fr.Status, writer),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Failover",
+				fmt.Sprintf("RDS DB cluster %s did not complete failover within %s: %s", id, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Cluster Status",
+				fmt.Sprintf("RDS DB cluster %s entered unexpected status during failover: %s", id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Failover",
+				fmt.Sprintf("Error while waiting for RDS DB cluster %s to fail over: %s", id, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s failed over successfully, new writer: %s", id, dbClusterWriter(fr.Value)),
+	})
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": id,
+	})
+}
+
+// dbClusterWriter returns the identifier of the writer DB instance of a DB cluster.
+func dbClusterWriter(cluster *awstypes.DBCluster) string {
+	for _, v := range cluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
+
+// dbClusterHasReader returns whether a DB cluster has a reader DB instance.
+func dbClusterHasReader(cluster *awstypes.DBCluster) bool {
+	return slices.ContainsFunc(cluster.DBClusterMembers, func(v awstypes.DBClusterMember) bool {
+		return !aws.ToBool(v.IsClusterWriter)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBCluster
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v),
+					testAccCheckClusterWriter(ctx, rName, rName+"-reader"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterWriter(ctx context.Context, id, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterByID(ctx, conn, id)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if aws.ToBool(v.IsClusterWriter) {
+				if got := aws.ToString(v.DBInstanceIdentifier); got != expected {
+					return fmt.Errorf("RDS Cluster (%s) writer is %s, expected %s", id, got, expected)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster (%s) has no writer", id)
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "writer" {
+  identifier         = "%[1]s-writer"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_rds_cluster_instance" "reader" {
+  identifier         = "%[1]s-reader"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  depends_on = [aws_rds_cluster_instance.writer]
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.cluster_identifier
+    target_db_instance_identifier = aws_rds_cluster_instance.reader.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.reader.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsInIDElem=ResourceName -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagOp=AddTagsToResource -TagInIDElem=ResourceName -UntagOp=RemoveTagsFromResource -UpdateTags
 //go:generate go run ../../generate/listpages/main.go -ListOps=DescribeDBShardGroups -Paginator=Marker
+//go:generate go run ../../generate/listpages/main.go -Items=DescribeDBClusters:DBClusters,DescribeDBInstances:DBInstances -- list_items_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
 //go:generate go run ../../generate/identitytests/main.go
@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceActionModel]
+}
+
+type rebootDBInstanceActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance, optionally failing over to the standby in another Availability Zone, and waits for the DB instance to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether the reboot is conducted through a Multi-AZ failover. The DB instance must be configured for Multi-AZ",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the DB instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := config.DBInstanceIdentifier.ValueString()
+	forceFailover := config.ForceFailover.ValueBool()
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS reboot DB instance action", map[string]any{
+		"db_instance_identifier": id,
+		"force_failover":         forceFailover,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	instance, err := findDBInstanceByID(ctx, conn, id)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"DB Instance Not Found",
+			fmt.Sprintf("RDS DB instance %s was not found", id),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Instance",
+			fmt.Sprintf("Could not describe RDS DB instance %s: %s", id, err),
+		)
+		return
+	}
+
+	if forceFailover && !aws.ToBool(instance.MultiAZ) {
+		resp.Diagnostics.AddError(
+			"Cannot Reboot DB Instance With Failover",
+			fmt.Sprintf("RDS DB instance %s is not configured for Multi-AZ, so cannot be rebooted with failover", id),
+		)
+		return
+	}
+
+	message := fmt.Sprintf("Rebooting RDS DB instance %s", id)
+	if forceFailover {
+		message += fmt.Sprintf(" with failover from Availability Zone %s", aws.ToString(instance.AvailabilityZone))
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message + "..."})
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+	}
+	if forceFailover {
+		input.ForceFailover = aws.Bool(true)
+	}
+	start := time.Now()
+	if _, err := conn.RebootDBInstance(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Reboot DB Instance",
+			fmt.Sprintf("Could not reboot RDS DB instance %s: %s", id, err),
+		)
+		return
+	}
+
+	fetch := func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBInstance], error) {
+		output, status, err := statusDBInstance(conn, id)(ctx)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBInstance]{}, err
+		}
+		if output == nil {
+			return actionwait.FetchResult[*awstypes.DBInstance]{}, &retry.NotFoundError{Message: fmt.Sprintf("RDS DB instance %s not found", id)}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBInstance]{Status: actionwait.Status(status), Value: output.(*awstypes.DBInstance)}, nil
+	}
+	progressSink := func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("RDS DB instance %s is %s", id, fr.Status),
+		})
+	}
+	failureStates := []actionwait.Status{
+		instanceStatusFailed,
+		instanceStatusStorageFull,
+	}
+
+	// The DB instance still reports itself as available immediately after the reboot request,
+	// so first wait for the reboot to begin.
+	// A short reboot can complete between polls, so a restart or failover event recorded since the request
+	// is also accepted as proof that the reboot has begun.
+	fetchRebootStarted := func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBInstance], error) {
+		fr, err := fetch(ctx)
+		if err != nil || fr.Status != instanceStatusAvailable {
+			return fr, err
+		}
+
+		started, err := dbInstanceRebootEventSince(ctx, conn, id, start)
+		if err != nil {
+			return fr, err
+		}
+		if started {
+			fr.Status = instanceStatusRebooting
+		}
+
+		return fr, nil
+	}
+	expected := "become available"
+	fr, err := actionwait.WaitForStatus(ctx, fetchRebootStarted, actionwait.Options[*awstypes.DBInstance]{
+		Timeout:            min(timeout, rebootDBInstanceStartTimeout),
+		Interval:           actionwait.FixedInterval(5 * time.Second),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{instanceStatusRebooting},
+		TransitionalStates: []actionwait.Status{instanceStatusAvailable},
+		FailureStates:      failureStates,
+		ProgressSink:       progressSink,
+	})
+	var startTimeoutErr *actionwait.TimeoutError
+	if errors.As(err, &startTimeoutErr) && time.Since(start) < timeout {
+		// If neither the reboot nor its events were seen, fall through to waiting for the DB instance to be available.
+		tflog.Warn(ctx, "Reboot of RDS DB instance not observed", map[string]any{
+			"db_instance_identifier": id,
+			"error":                  startTimeoutErr.Error(),
+		})
+		err = nil
+	}
+	if err == nil {
+		fr, err = actionwait.WaitForStatus(ctx, fetch, actionwait.Options[*awstypes.DBInstance]{
+			Timeout:          max(timeout-time.Since(start), time.Second),
+			Interval:         actionwait.FixedInterval(10 * time.Second),
+			ProgressInterval: 30 * time.Second,
+			SuccessStates:    []actionwait.Status{instanceStatusAvailable},
+			TransitionalStates: []actionwait.Status{
+				instanceStatusBackingUp,
+				instanceStatusModifying,
+				instanceStatusRebooting,
+			},
+			FailureStates: failureStates,
+			ProgressSink:  progressSink,
+		})
+	}
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Instance Reboot",
+				fmt.Sprintf("RDS DB instance %s did not %s within %s: %s", id, expected, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"DB Instance Reboot Failed",
+				fmt.Sprintf("RDS DB instance %s entered status %s after reboot", id, failureErr.Status),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Instance Status",
+				fmt.Sprintf("RDS DB instance %s entered unexpected status after reboot: %s", id, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Instance Reboot",
+				fmt.Sprintf("Error while waiting for RDS DB instance %s to reboot: %s", id, err),
+			)
+		}
+		return
+	}
+
+	message = fmt.Sprintf("RDS DB instance %s rebooted successfully", id)
+	if forceFailover && fr.Value != nil {
+		message += fmt.Sprintf(" and is now in Availability Zone %s", aws.ToString(fr.Value.AvailabilityZone))
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+
+	tflog.Info(ctx, "RDS reboot DB instance action completed successfully", map[string]any{
+		"db_instance_identifier": id,
+	})
+}
+
+// rebootDBInstanceStartTimeout is the maximum time to wait for a DB instance reboot to be observed.
+const rebootDBInstanceStartTimeout = 5 * time.Minute
+
+// dbInstanceRebootEventSince returns whether a restart or failover event has been recorded for the specified DB instance since the specified time.
+func dbInstanceRebootEventSince(ctx context.Context, conn *rds.Client, id string, since time.Time) (bool, error) {
+	input := rds.DescribeEventsInput{
+		EventCategories:  []string{"availability", "failover"},
+		SourceIdentifier: aws.String(id),
+		SourceType:       awstypes.SourceTypeDbInstance,
+		StartTime:        aws.Time(since),
+	}
+
+	pages := rds.NewDescribeEventsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(page.Events) > 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
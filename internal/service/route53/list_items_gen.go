@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by "internal/generate/listpages/main.go -Items=ListHostedZones:HostedZones -- list_items_gen.go"; DO NOT EDIT.
+
+package route53
+
+import (
+	"context"
+	"iter"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func listHostedZonesItems(ctx context.Context, conn *route53.Client, input *route53.ListHostedZonesInput, optFns ...func(*route53.Options)) iter.Seq2[awstypes.HostedZone, error] {
+	return func(yield func(awstypes.HostedZone, error) bool) {
+		pages := route53.NewListHostedZonesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, optFns...)
+			if err != nil {
+				var zero awstypes.HostedZone
+				yield(zero, smarterr.NewError(err))
+				return
+			}
+
+			for _, v := range page.HostedZones {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
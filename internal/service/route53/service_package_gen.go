@@ -166,7 +166,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				IdentifierAttribute: "zone_id",
 				ResourceType:        "hostedzone",
 			}),
-			Region: unique.Make(inttypes.ResourceRegionDisabled()),
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Identity: inttypes.GlobalSingleParameterIdentity("zone_id"),
+			Import: inttypes.SDKv2Import{
+				CustomImport: true,
+			},
 		},
 		{
 			Factory:  resourceZoneAssociation,
@@ -193,6 +197,17 @@ func (p *servicePackage) SDKListResources(ctx context.Context) iter.Seq[*inttype
 				inttypes.WithMutableIdentity(),
 			),
 		},
+		{
+			Factory:  zoneResourceAsListResource,
+			TypeName: "aws_route53_zone",
+			Name:     "Hosted Zone",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: "zone_id",
+				ResourceType:        "hostedzone",
+			}),
+			Identity: inttypes.GlobalSingleParameterIdentity("zone_id"),
+		},
 	})
 }
 
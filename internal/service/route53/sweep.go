@@ -4,6 +4,7 @@
 package route53
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -12,29 +13,28 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_health_check", &sweep.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
 	})
 
-	resource.AddTestSweepers("aws_route53_key_signing_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_key_signing_key", &sweep.Sweeper{
 		Name: "aws_route53_key_signing_key",
 		F:    sweepKeySigningKeys,
 	})
 
-	resource.AddTestSweepers("aws_route53_query_log", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_query_log", &sweep.Sweeper{
 		Name: "aws_route53_query_log",
 		F:    sweepQueryLogs,
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy",
 		F:    sweepTrafficPolicies,
 		Dependencies: []string{
@@ -42,12 +42,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_traffic_policy_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_traffic_policy_instance", &sweep.Sweeper{
 		Name: "aws_route53_traffic_policy_instance",
 		F:    sweepTrafficPolicyInstances,
 	})
 
-	resource.AddTestSweepers("aws_route53_zone", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_zone", &sweep.Sweeper{
 		Name: "aws_route53_zone",
 		Dependencies: []string{
 			"aws_service_discovery_http_namespace",
@@ -61,8 +61,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepHealthChecks(region string) error {
-	ctx := sweep.Context(region)
+func sweepHealthChecks(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -76,6 +75,7 @@ func sweepHealthChecks(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Route 53 Health Check sweep for %s: %s", region, err)
 			return nil
 		}
@@ -109,8 +109,7 @@ func sweepHealthChecks(region string) error {
 	return nil
 }
 
-func sweepKeySigningKeys(region string) error {
-	ctx := sweep.Context(region)
+func sweepKeySigningKeys(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -124,6 +123,7 @@ func sweepKeySigningKeys(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Route53 Key-Signing Key sweep for %s: %s", region, err)
 			return nil
 		}
@@ -171,8 +171,7 @@ func sweepKeySigningKeys(region string) error {
 	return nil
 }
 
-func sweepQueryLogs(region string) error {
-	ctx := sweep.Context(region)
+func sweepQueryLogs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -188,6 +187,7 @@ func sweepQueryLogs(region string) error {
 		// In unsupported AWS partitions, the API may return an error even the SDK cannot handle.
 		// Reference: https://github.com/aws/aws-sdk-go/issues/3313
 		if awsv2.SkipSweepError(err) || tfawserr.ErrMessageContains(err, errCodeSerializationError, "failed to unmarshal error message") || tfawserr.ErrMessageContains(err, errCodeAccessDenied, "Unable to determine service/operation name to be authorized") {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Route53 Query Logging Config sweep for %s: %s", region, err)
 			return nil
 		}
@@ -216,8 +216,7 @@ func sweepQueryLogs(region string) error {
 	return nil
 }
 
-func sweepTrafficPolicies(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficPolicies(ctx context.Context, region string) error {
 	if region == endpoints.UsGovEast1RegionID || region == endpoints.UsGovWest1RegionID {
 		log.Printf("[WARN] Skipping Route 53 Traffic Policy sweep for region: %s", region)
 		return nil
@@ -247,6 +246,7 @@ func sweepTrafficPolicies(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Route 53 Traffic Policy sweep for %s: %s", region, err)
 		return nil
 	}
@@ -264,8 +264,7 @@ func sweepTrafficPolicies(region string) error {
 	return nil
 }
 
-func sweepTrafficPolicyInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepTrafficPolicyInstances(ctx context.Context, region string) error {
 	if region == endpoints.UsGovEast1RegionID || region == endpoints.UsGovWest1RegionID {
 		log.Printf("[WARN] Skipping Route 53 Traffic Policy Instance sweep for region: %s", region)
 		return nil
@@ -295,6 +294,7 @@ func sweepTrafficPolicyInstances(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Route 53 Traffic Policy Instance sweep for %s: %s", region, err)
 		return nil
 	}
@@ -312,8 +312,7 @@ func sweepTrafficPolicyInstances(region string) error {
 	return nil
 }
 
-func sweepZones(region string) error {
-	ctx := sweep.Context(region)
+func sweepZones(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -327,6 +326,7 @@ func sweepZones(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Route 53 Hosted Zone sweep for %s: %s", region, err)
 			return nil
 		}
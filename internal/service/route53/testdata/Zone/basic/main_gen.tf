@@ -0,0 +1,12 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_route53_zone" "test" {
+  name = var.zoneName
+}
+
+variable "zoneName" {
+  type     = string
+  nullable = false
+}
+
@@ -0,0 +1,14 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_route53_zone" "test" {
+  count = 2
+
+  name = "${count.index}.${var.zoneName}"
+}
+
+variable "zoneName" {
+  description = "Name of the parent domain"
+  type        = string
+  nullable    = false
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -34,6 +35,16 @@ import (
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
 // @Tags(identifierAttribute="zone_id", resourceType="hostedzone")
+// @IdentityAttribute("zone_id")
+// @CustomImport
+// @Testing(name="Zone")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/route53;route53.GetHostedZoneOutput")
+// @Testing(idAttrDuplicates="zone_id")
+// @Testing(domainTfVar="zoneName")
+// @Testing(generator=false)
+// @Testing(importIgnore="force_destroy")
+// @Testing(preIdentityVersion="v6.31.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneCreate,
@@ -42,7 +53,7 @@ func resourceZone() *schema.Resource {
 		DeleteWithoutTimeout: resourceZoneDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceZoneImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -357,6 +368,19 @@ func updateEnableAcceleratedRecovery(ctx context.Context, conn *route53.Client,
 	return nil
 }
 
+func resourceZoneImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// Allow import using an ID with the "/hostedzone/" prefix.
+	if id := d.Id(); id != "" {
+		d.SetId(cleanZoneID(id))
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func findHostedZoneByID(ctx context.Context, conn *route53.Client, id string) (*route53.GetHostedZoneOutput, error) {
 	input := route53.GetHostedZoneInput{
 		Id: aws.String(id),
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_route53_zone")
+func zoneResourceAsListResource() inttypes.ListResourceForSDK {
+	l := zoneListResource{}
+	l.SetResourceSchema(resourceZone())
+	return &l
+}
+
+type zoneListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type zoneListResourceModel struct{}
+
+func (l *zoneListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query zoneListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.Route53Client(ctx)
+
+	tflog.Info(ctx, "Listing Route53 Hosted Zones")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input route53.ListHostedZonesInput
+		for item, err := range listHostedZonesItems(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			zoneID := cleanZoneID(aws.ToString(item.Id))
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), zoneID)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(zoneID)
+
+			diags := resourceZoneRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading Route53 Hosted Zone", map[string]any{
+					names.AttrID: zoneID,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = normalizeDomainName(aws.ToString(item.Name))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
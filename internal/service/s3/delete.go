@@ -175,7 +175,7 @@ func deletePageOfObjects(ctx context.Context, conn *s3.Client, bucket string, pa
 	return deletePage(ctx, conn, bucket, false, toDelete)
 }
 
-func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool, toDelete []types.ObjectIdentifier) (int64, error) {
+func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool, toDelete []types.ObjectIdentifier, optFns ...func(*s3.Options)) (int64, error) {
 	if len(toDelete) == 0 {
 		return 0, nil
 	}
@@ -201,7 +201,7 @@ func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool,
 		key := aws.ToString(v.Key)
 		versionID := aws.ToString(v.VersionId)
 
-		err := deleteObjectVersion(ctx, conn, bucket, key, versionID, force)
+		err := deleteObjectVersion(ctx, conn, bucket, key, versionID, force, optFns...)
 		if err == nil {
 			nObjects++
 			continue
@@ -235,7 +235,7 @@ func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool,
 			input.BypassGovernanceRetention = aws.Bool(force)
 		}
 
-		output, err := conn.DeleteObjects(ctx, input)
+		output, err := conn.DeleteObjects(ctx, input, optFns...)
 
 		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 			return int64(len(toDelete)), nil
@@ -269,7 +269,7 @@ func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool,
 				VersionId: aws.String(versionID),
 			}
 
-			_, err := conn.PutObjectLegalHold(ctx, input)
+			_, err := conn.PutObjectLegalHold(ctx, input, optFns...)
 
 			if err != nil {
 				// Add the original error and the new error.
@@ -283,7 +283,7 @@ func deletePage(ctx context.Context, conn *s3.Client, bucket string, force bool,
 					VersionId: aws.String(versionID),
 				}
 
-				_, err := conn.DeleteObject(ctx, input)
+				_, err := conn.DeleteObject(ctx, input, optFns...)
 
 				if err != nil {
 					errs = append(errs, fmt.Errorf("deleting: %w", newObjectVersionError(key, versionID, err)))
@@ -84,6 +84,11 @@ var (
 	DirectoryBucketNameRegex = directoryBucketNameRegex
 
 	LifecycleConfigEqual = lifecycleConfigEqual
+
+	LocalFileETag          = localFileETag
+	MultipartPartSize      = multipartPartSize
+	SyncDirectoryExcluded  = syncDirectoryExcluded
+	SyncDirectoryKeyPrefix = syncDirectoryKeyPrefix
 )
 
 type (
@@ -0,0 +1,599 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// syncDirectoryPartSize is the part size used for multipart uploads.
+	// It matches the AWS CLI default so that ETags of objects uploaded by either tool can be compared.
+	syncDirectoryPartSize = 8 * 1024 * 1024
+	// syncDirectoryDeleteBatchSize is the maximum number of keys in a DeleteObjects request.
+	syncDirectoryDeleteBatchSize = 1000
+)
+
+// @Action(aws_s3_sync_directory, name="Sync Directory")
+func newSyncDirectoryAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &syncDirectoryAction{}, nil
+}
+
+var (
+	_ action.Action = (*syncDirectoryAction)(nil)
+)
+
+type syncDirectoryAction struct {
+	framework.ActionWithModel[syncDirectoryActionModel]
+}
+
+type syncDirectoryActionModel struct {
+	framework.WithRegionModel
+	Bucket       types.String         `tfsdk:"bucket"`
+	CacheControl types.String         `tfsdk:"cache_control"`
+	Concurrency  types.Int64          `tfsdk:"concurrency"`
+	Delete       types.Bool           `tfsdk:"delete"`
+	Exclude      fwtypes.ListOfString `tfsdk:"exclude"`
+	KeyPrefix    types.String         `tfsdk:"key_prefix"`
+	Source       types.String         `tfsdk:"source"`
+	Timeout      types.Int64          `tfsdk:"timeout"`
+}
+
+// syncDirectoryFile is a local file to be synced.
+type syncDirectoryFile struct {
+	key  string
+	path string
+	size int64
+}
+
+func (a *syncDirectoryAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads the contents of a local directory to an S3 bucket, skipping files that are unchanged and optionally deleting objects that no longer exist locally.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Description: "Name of the bucket to upload to",
+				Required:    true,
+			},
+			"cache_control": schema.StringAttribute{
+				Description: "Cache-Control header to set on uploaded objects",
+				Optional:    true,
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Maximum number of files uploaded at the same time (default: 10)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"delete": schema.BoolAttribute{
+				Description: "Whether to delete objects under the key prefix that don't exist in the source directory",
+				Optional:    true,
+			},
+			"exclude": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Glob patterns, relative to the source directory, of files and directories that aren't synced",
+				Optional:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Key prefix under which files are uploaded",
+				Optional:    true,
+			},
+			names.AttrSource: schema.StringAttribute{
+				Description: "Path to the local directory to upload",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds for the sync to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *syncDirectoryAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config syncDirectoryActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := config.Bucket.ValueString()
+	source := config.Source.ValueString()
+	prefix := syncDirectoryKeyPrefix(config.KeyPrefix.ValueString())
+	deleteOrphans := config.Delete.ValueBool()
+	exclude := fwflex.ExpandFrameworkStringValueList(ctx, config.Exclude)
+
+	conn := a.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = a.Meta().S3ExpressClient(ctx)
+	}
+
+	var optFns []func(*s3.Options)
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == endpoints.AwsGlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	concurrency := 10
+	if !config.Concurrency.IsNull() {
+		concurrency = int(config.Concurrency.ValueInt64())
+	}
+
+	timeout := 30 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	destination := fmt.Sprintf("s3://%s/%s", bucket, prefix)
+
+	tflog.Info(ctx, "Starting S3 sync directory action", map[string]any{
+		names.AttrSource:  source,
+		"destination":     destination,
+		"delete":          deleteOrphans,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	if fi, err := os.Stat(source); err != nil {
+		resp.Diagnostics.AddError(
+			"Source Directory Not Found",
+			fmt.Sprintf("Could not read source directory %s: %s", source, err),
+		)
+		return
+	} else if !fi.IsDir() {
+		resp.Diagnostics.AddError(
+			"Source Is Not a Directory",
+			fmt.Sprintf("Source %s is not a directory", source),
+		)
+		return
+	}
+
+	files, err := findSyncDirectoryFiles(source, prefix, exclude)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Source Directory",
+			fmt.Sprintf("Could not read source directory %s: %s", source, err),
+		)
+		return
+	}
+
+	objects, err := findObjectsByBucketAndPrefix(ctx, conn, bucket, prefix, optFns...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Objects",
+			fmt.Sprintf("Could not list objects in %s: %s", destination, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Syncing %d files from %s to %s (%d existing objects)...", len(files), source, destination, len(objects)),
+	})
+
+	// Progress events and counters are updated from concurrent uploads.
+	var mutex sync.Mutex
+	var nUploaded, nUnchanged, nDeleted int
+	sendProgress := func(message string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = syncDirectoryPartSize
+	}, manager.WithUploaderRequestOptions(optFns...))
+
+	// The first failed upload cancels the remaining uploads.
+	uploadCtx, cancelUploads := context.WithCancel(ctx)
+	defer cancelUploads()
+
+	var g tfsync.Group
+	sem := make(chan struct{}, concurrency)
+
+	for _, file := range files {
+		g.Go(uploadCtx, func(ctx context.Context) error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if object, ok := objects[file.key]; ok && aws.ToInt64(object.Size) == file.size {
+				unchanged, err := localFileMatchesETag(file.path, file.size, aws.ToString(object.ETag))
+				if err != nil {
+					cancelUploads()
+					return fmt.Errorf("reading %s: %w", file.path, err)
+				}
+
+				if unchanged {
+					mutex.Lock()
+					nUnchanged++
+					mutex.Unlock()
+					return nil
+				}
+			}
+
+			contentType, err := uploadSyncDirectoryFile(ctx, uploader, bucket, file, config.CacheControl.ValueString())
+			if err != nil {
+				if ctx.Err() != nil {
+					// Cancelled by another failed upload or the timeout.
+					return nil
+				}
+				cancelUploads()
+				return err
+			}
+
+			mutex.Lock()
+			nUploaded++
+			mutex.Unlock()
+			sendProgress(fmt.Sprintf("Uploaded %s (%s, %d bytes)", file.key, contentType, file.size))
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(uploadCtx); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Upload Files",
+			fmt.Sprintf("Could not upload files from %s to %s (%d uploaded, %d unchanged): %s", source, destination, nUploaded, nUnchanged, err),
+		)
+		return
+	}
+
+	if err := ctx.Err(); err != nil {
+		resp.Diagnostics.AddError(
+			"Timeout Syncing Directory",
+			fmt.Sprintf("Sync of %s to %s did not complete within %s (%d uploaded, %d unchanged): %s", source, destination, timeout, nUploaded, nUnchanged, err),
+		)
+		return
+	}
+
+	if deleteOrphans {
+		local := make(map[string]struct{}, len(files))
+		for _, file := range files {
+			local[file.key] = struct{}{}
+		}
+
+		var toDelete []awstypes.ObjectIdentifier
+		for key := range objects {
+			if _, ok := local[key]; !ok && !syncDirectoryExcluded(strings.TrimPrefix(key, prefix), exclude) {
+				toDelete = append(toDelete, awstypes.ObjectIdentifier{Key: aws.String(key)})
+			}
+		}
+		slices.SortFunc(toDelete, func(a, b awstypes.ObjectIdentifier) int {
+			return strings.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+		})
+
+		for chunk := range slices.Chunk(toDelete, syncDirectoryDeleteBatchSize) {
+			n, err := deletePage(ctx, conn, bucket, false, chunk, optFns...)
+			nDeleted += int(n)
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Failed to Delete Objects",
+					fmt.Sprintf("Could not delete objects from %s that don't exist in %s (%d deleted): %s", destination, source, nDeleted, err),
+				)
+				return
+			}
+
+			for _, v := range chunk {
+				sendProgress(fmt.Sprintf("Deleted %s", aws.ToString(v.Key)))
+			}
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Synced %s to %s: %d uploaded, %d unchanged, %d deleted", source, destination, nUploaded, nUnchanged, nDeleted),
+	})
+
+	tflog.Info(ctx, "S3 sync directory action completed successfully", map[string]any{
+		names.AttrSource: source,
+		"destination":    destination,
+		"uploaded":       nUploaded,
+		"unchanged":      nUnchanged,
+		"deleted":        nDeleted,
+	})
+}
+
+// syncDirectoryKeyPrefix normalizes a key prefix so that keys can be formed by appending relative paths.
+func syncDirectoryKeyPrefix(prefix string) string {
+	prefix = strings.TrimPrefix(prefix, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return prefix
+}
+
+// findSyncDirectoryFiles returns the regular files in the source directory, excluding those matching any of the
+// specified glob patterns, along with the object keys they are uploaded to.
+func findSyncDirectoryFiles(source, prefix string, exclude []string) ([]syncDirectoryFile, error) {
+	var files []syncDirectoryFile
+
+	err := filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range exclude {
+			if matched, err := syncDirectoryMatch(pattern, rel); err != nil {
+				return fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+			} else if matched {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files.
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		files = append(files, syncDirectoryFile{
+			key:  prefix + rel,
+			path: p,
+			size: fi.Size(),
+		})
+
+		return nil
+	})
+
+	return files, err
+}
+
+// syncDirectoryMatch returns whether a slash-separated path relative to the source directory matches a glob pattern.
+// Patterns without a `/` are also matched against the path's final element, so `*.map` matches `js/app.js.map`.
+func syncDirectoryMatch(pattern, rel string) (bool, error) {
+	if matched, err := path.Match(pattern, rel); err != nil || matched {
+		return matched, err
+	}
+
+	if strings.Contains(pattern, "/") {
+		return false, nil
+	}
+
+	return path.Match(pattern, path.Base(rel))
+}
+
+// syncDirectoryExcluded returns whether a slash-separated path relative to the source directory, or any of its
+// parent directories, matches any of the specified glob patterns.
+func syncDirectoryExcluded(rel string, exclude []string) bool {
+	for {
+		for _, pattern := range exclude {
+			if matched, _ := syncDirectoryMatch(pattern, rel); matched {
+				return true
+			}
+		}
+
+		dir := path.Dir(rel)
+		if dir == "." || dir == "/" || dir == rel {
+			return false
+		}
+		rel = dir
+	}
+}
+
+// findObjectsByBucketAndPrefix returns the objects under the specified key prefix, keyed by object key.
+func findObjectsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]awstypes.Object, error) {
+	input := s3.ListObjectsV2Input{
+		Bucket:       aws.String(bucket),
+		EncodingType: awstypes.EncodingTypeUrl,
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	output := make(map[string]awstypes.Object)
+
+	pages := s3.NewListObjectsV2Paginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Reverse URL-encoding from requested EncodingType: "url"
+		for _, v := range page.Contents {
+			key, err := url.QueryUnescape(aws.ToString(v.Key))
+			if err != nil {
+				return nil, fmt.Errorf("unescaping object key: %w", err)
+			}
+
+			output[key] = v
+		}
+	}
+
+	return output, nil
+}
+
+// localFileMatchesETag returns whether the content of a local file matches an S3 object's ETag.
+// The ETag of an object uploaded in a single part is the MD5 digest of its content. The ETag of an object
+// uploaded in multiple parts is the MD5 digest of the concatenated part digests followed by the number of parts.
+// Objects encrypted with SSE-KMS or SSE-C have ETags that are not MD5 digests, so never match.
+func localFileMatchesETag(p string, size int64, etag string) (bool, error) {
+	etag = strings.Trim(etag, `"`)
+
+	var partSize int64
+	if before, after, ok := strings.Cut(etag, "-"); ok {
+		nParts, err := strconv.ParseInt(after, 10, 64)
+		if err != nil || nParts < 1 {
+			return false, nil
+		}
+
+		partSize = multipartPartSize(size, nParts)
+		etag = before
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	digest, err := localFileETag(f, partSize)
+	if err != nil {
+		return false, err
+	}
+
+	return digest == etag, nil
+}
+
+// multipartPartSize returns the part size that was most likely used to upload an object of the specified size
+// in the specified number of parts. This is either this action's part size, or the smallest whole number of MiB
+// that would produce the number of parts.
+func multipartPartSize(size, nParts int64) int64 {
+	if (size+syncDirectoryPartSize-1)/syncDirectoryPartSize == nParts {
+		return syncDirectoryPartSize
+	}
+
+	const mib = 1024 * 1024
+	partSize := (size + nParts - 1) / nParts
+
+	return (partSize + mib - 1) / mib * mib
+}
+
+// localFileETag computes the ETag, without the number of parts, that S3 would assign to the content of r when uploaded
+// in parts of the specified size. A part size of zero indicates a single part upload.
+func localFileETag(r io.Reader, partSize int64) (string, error) {
+	if partSize == 0 {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+		if n > 0 {
+			digests = h.Sum(digests)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	h := md5.New()
+	h.Write(digests)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// syncDirectoryContentType returns the Content-Type of a local file, based on its extension or, failing that, its content.
+func syncDirectoryContentType(f io.ReadSeeker, p string) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(p)); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// uploadSyncDirectoryFile uploads a local file, using a multipart upload for files larger than the part size,
+// and returns the object's Content-Type.
+func uploadSyncDirectoryFile(ctx context.Context, uploader *manager.Uploader, bucket string, file syncDirectoryFile, cacheControl string) (string, error) {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	contentType, err := syncDirectoryContentType(f, file.path)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", file.path, err)
+	}
+
+	input := s3.PutObjectInput{
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(contentType),
+		Key:         aws.String(file.key),
+	}
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	if _, err := uploader.Upload(ctx, &input); err != nil {
+		return "", fmt.Errorf("uploading %s to S3 Object (%s) in Bucket (%s): %w", file.path, file.key, bucket, err)
+	}
+
+	return contentType, nil
+}
@@ -0,0 +1,303 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSyncDirectoryKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prefix string
+		want   string
+	}{
+		"empty":          {prefix: "", want: ""},
+		"no slash":       {prefix: "site", want: "site/"},
+		"trailing slash": {prefix: "site/", want: "site/"},
+		"leading slash":  {prefix: "/site/v1", want: "site/v1/"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.SyncDirectoryKeyPrefix(testCase.prefix); got != testCase.want {
+				t.Errorf("SyncDirectoryKeyPrefix(%q) = %q, want %q", testCase.prefix, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSyncDirectoryExcluded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rel     string
+		exclude []string
+		want    bool
+	}{
+		"no patterns":             {rel: "index.html", want: false},
+		"no match":                {rel: "index.html", exclude: []string{"*.map"}, want: false},
+		"match":                   {rel: "app.js.map", exclude: []string{"*.map"}, want: true},
+		"wildcard in directory":   {rel: "js/app.js.map", exclude: []string{"*.map"}, want: true},
+		"nested pattern":          {rel: "js/app.js.map", exclude: []string{"js/*.map"}, want: true},
+		"nested pattern no match": {rel: "css/app.css.map", exclude: []string{"js/*.map"}, want: false},
+		"name in directory":       {rel: "docs/.git/HEAD", exclude: []string{".git"}, want: true},
+		"parent directory match":  {rel: ".git/refs/heads/main", exclude: []string{".git"}, want: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.SyncDirectoryExcluded(testCase.rel, testCase.exclude); got != testCase.want {
+				t.Errorf("SyncDirectoryExcluded(%q, %q) = %t, want %t", testCase.rel, testCase.exclude, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMultipartPartSize(t *testing.T) {
+	t.Parallel()
+
+	const mib = 1024 * 1024
+
+	testCases := map[string]struct {
+		size   int64
+		nParts int64
+		want   int64
+	}{
+		"default part size":     {size: 20 * mib, nParts: 3, want: 8 * mib},
+		"minimum part size":     {size: 20 * mib, nParts: 4, want: 5 * mib},
+		"inferred part size":    {size: 100 * mib, nParts: 7, want: 15 * mib},
+		"partial last part":     {size: 17*mib + 1, nParts: 3, want: 8 * mib},
+		"single multipart part": {size: 1, nParts: 1, want: 8 * mib},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.MultipartPartSize(testCase.size, testCase.nParts); got != testCase.want {
+				t.Errorf("MultipartPartSize(%d, %d) = %d, want %d", testCase.size, testCase.nParts, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestLocalFileETag(t *testing.T) {
+	t.Parallel()
+
+	md5Hex := func(parts ...string) string {
+		h := md5.New()
+		for _, v := range parts {
+			h.Write([]byte(v))
+		}
+		return hex.EncodeToString(h.Sum(nil))
+	}
+	md5Of := func(s string) string {
+		v := md5.Sum([]byte(s))
+		return string(v[:])
+	}
+
+	testCases := map[string]struct {
+		content  string
+		partSize int64
+		want     string
+	}{
+		"single part": {
+			content: "hello",
+			want:    "5d41402abc4b2a76b9719d911017c592",
+		},
+		"single part empty": {
+			content: "",
+			want:    "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"multipart": {
+			content:  "hello",
+			partSize: 2,
+			want:     md5Hex(md5Of("he"), md5Of("ll"), md5Of("o")),
+		},
+		"multipart exact parts": {
+			content:  "hell",
+			partSize: 2,
+			want:     md5Hex(md5Of("he"), md5Of("ll")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.LocalFileETag(strings.NewReader(testCase.content), testCase.partSize)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("LocalFileETag(%q, %d) = %q, want %q", testCase.content, testCase.partSize, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccS3SyncDirectoryAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	source := t.TempDir()
+
+	files := map[string]string{
+		"index.html":   "<html><body>hello</body></html>",
+		"css/site.css": "body { color: red; }",
+		"robots":       "User-agent: *",
+	}
+	for k, v := range files {
+		testAccWriteSyncDirectoryFile(t, source, k, v)
+	}
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncDirectoryActionConfig_basic(rName, source, "v1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncDirectoryObject(ctx, rName, "site/index.html", "text/html; charset=utf-8", files["index.html"]),
+					testAccCheckSyncDirectoryObject(ctx, rName, "site/css/site.css", "text/css; charset=utf-8", files["css/site.css"]),
+					testAccCheckSyncDirectoryObject(ctx, rName, "site/robots", "text/plain; charset=utf-8", files["robots"]),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteSyncDirectoryFile(t, source, "index.html", "<html><body>goodbye</body></html>")
+					if err := os.Remove(filepath.Join(source, "robots")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccSyncDirectoryActionConfig_basic(rName, source, "v2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncDirectoryObject(ctx, rName, "site/index.html", "text/html; charset=utf-8", "<html><body>goodbye</body></html>"),
+					testAccCheckSyncDirectoryObject(ctx, rName, "site/css/site.css", "text/css; charset=utf-8", files["css/site.css"]),
+					testAccCheckSyncDirectoryObjectNotExists(ctx, rName, "site/robots"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWriteSyncDirectoryFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckSyncDirectoryObject(ctx context.Context, bucket, key, contentType, body string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		output, err := conn.GetObject(ctx, &input)
+		if err != nil {
+			return err
+		}
+		defer output.Body.Close()
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %v, want %v", key, got, contentType)
+		}
+
+		got, err := io.ReadAll(output.Body)
+		if err != nil {
+			return err
+		}
+
+		if string(got) != body {
+			return fmt.Errorf("S3 Object (%s) body = %v, want %v", key, string(got), body)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSyncDirectoryObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) in Bucket (%s) still exists", key, bucket)
+	}
+}
+
+func testAccSyncDirectoryActionConfig_basic(rName, source, version string, deleteOrphans bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+action "aws_s3_sync_directory" "test" {
+  config {
+    bucket     = aws_s3_bucket.test.bucket
+    source     = %[2]q
+    key_prefix = "site"
+    delete     = %[4]t
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[3]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.aws_s3_sync_directory.test]
+    }
+  }
+}
+`, rName, source, version, deleteOrphans)
+}
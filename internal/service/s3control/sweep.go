@@ -4,13 +4,13 @@
 package s3control
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -19,12 +19,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3control_access_grant", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grant", &sweep.Sweeper{
 		Name: "aws_s3control_access_grant",
 		F:    sweepAccessGrants,
 	})
 
-	resource.AddTestSweepers("aws_s3control_access_grants_location", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grants_location", &sweep.Sweeper{
 		Name: "aws_s3control_access_grants_location",
 		F:    sweepAccessGrantsLocations,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_access_grants_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_access_grants_instance", &sweep.Sweeper{
 		Name: "aws_s3control_access_grants_instance",
 		F:    sweepAccessGrantsInstances,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_access_point", &sweep.Sweeper{
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 		Dependencies: []string{
@@ -48,24 +48,23 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_multi_region_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_multi_region_access_point",
 		F:    sweepMultiRegionAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_object_lambda_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_object_lambda_access_point", &sweep.Sweeper{
 		Name: "aws_s3control_object_lambda_access_point",
 		F:    sweepObjectLambdaAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_s3control_storage_lens_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3control_storage_lens_configuration", &sweep.Sweeper{
 		Name: "aws_s3control_storage_lens_configuration",
 		F:    sweepStorageLensConfigurations,
 	})
 }
 
-func sweepAccessGrants(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessGrants(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -82,6 +81,7 @@ func sweepAccessGrants(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Access Grant sweep for %s: %s", region, err)
 			return nil
 		}
@@ -106,8 +106,7 @@ func sweepAccessGrants(region string) error {
 	return nil
 }
 
-func sweepAccessGrantsInstances(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessGrantsInstances(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -124,6 +123,7 @@ func sweepAccessGrantsInstances(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Access Grants Instance sweep for %s: %s", region, err)
 			return nil
 		}
@@ -148,8 +148,7 @@ func sweepAccessGrantsInstances(region string) error {
 	return nil
 }
 
-func sweepAccessGrantsLocations(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessGrantsLocations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -166,6 +165,7 @@ func sweepAccessGrantsLocations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Access Grants Location sweep for %s: %s", region, err)
 			return nil
 		}
@@ -190,8 +190,7 @@ func sweepAccessGrantsLocations(region string) error {
 	return nil
 }
 
-func sweepAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -208,6 +207,7 @@ func sweepAccessPoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Access Point sweep for %s: %s", region, err)
 			return nil
 		}
@@ -241,8 +241,7 @@ func sweepAccessPoints(region string) error {
 	return nil
 }
 
-func sweepMultiRegionAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepMultiRegionAccessPoints(ctx context.Context, region string) error {
 	if region != endpoints.UsWest2RegionID {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
@@ -263,6 +262,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for %s: %s", region, err)
 			return nil
 		}
@@ -289,8 +289,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 	return nil
 }
 
-func sweepObjectLambdaAccessPoints(region string) error {
-	ctx := sweep.Context(region)
+func sweepObjectLambdaAccessPoints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -307,6 +306,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Object Lambda Access Point sweep for %s: %s", region, err)
 			return nil
 		}
@@ -333,8 +333,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 	return nil
 }
 
-func sweepStorageLensConfigurations(region string) error {
-	ctx := sweep.Context(region)
+func sweepStorageLensConfigurations(ctx context.Context, region string) error {
 	if region == endpoints.UsGovEast1RegionID || region == endpoints.UsGovWest1RegionID {
 		log.Printf("[WARN] Skipping S3 Storage Lens Configuration sweep for region: %s", region)
 		return nil
@@ -355,6 +354,7 @@ func sweepStorageLensConfigurations(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping S3 Storage Lens Configuration sweep for %s: %s", region, err)
 			return nil
 		}
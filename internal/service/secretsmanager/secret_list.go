@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"iter"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -45,9 +48,11 @@ func (l *listResourceSecret) List(ctx context.Context, request list.ListRequest,
 		}
 	}
 
+	var input secretsmanager.ListSecretsInput
+	input.Filters = listQueryFilters(ctx)
+
 	tflog.Info(ctx, "Listing Secrets Manager Secret")
 	stream.Results = func(yield func(list.ListResult) bool) {
-		var input secretsmanager.ListSecretsInput
 		for item, err := range listSecrets(ctx, conn, &input) {
 			if err != nil {
 				result := fwdiag.NewListResultErrorDiagnostic(err)
@@ -113,3 +118,26 @@ func listSecrets(ctx context.Context, conn *secretsmanager.Client, input *secret
 		}
 	}
 }
+
+// listQueryFilters returns Secrets Manager API filters for the standard list resource query arguments in the specified Context.
+// Secrets Manager filters are case-insensitive prefix matches, so the query arguments are not marked as applied
+// and are also evaluated by the provider.
+func listQueryFilters(ctx context.Context) []awstypes.Filter {
+	query, ok := listresource.QueryFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	m := make(map[string][]string)
+	if v := query.NamePrefix; v != "" {
+		m[names.AttrName] = []string{v}
+	}
+	// Values of a single filter are ORed together, so only one tag key can be used.
+	if i := slices.IndexFunc(query.TagFilters, func(v listresource.TagFilter) bool {
+		return v.Key != ""
+	}); i != -1 {
+		m["tag-key"] = []string{query.TagFilters[i].Key}
+	}
+
+	return namevaluesfilters.New(m).SecretsManagerFilters()
+}
@@ -4,6 +4,7 @@
 package servicecatalog
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,38 +14,37 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/servicecatalog"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicecatalog/types"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_servicecatalog_budget_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_budget_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_budget_resource_association",
 		Dependencies: []string{},
 		F:            sweepBudgetResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_constraint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_constraint", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_constraint",
 		Dependencies: []string{},
 		F:            sweepConstraints,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_principal_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_principal_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepPrincipalPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product_portfolio_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_product_portfolio_association",
 		Dependencies: []string{},
 		F:            sweepProductPortfolioAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_product", &sweep.Sweeper{
 		Name: "aws_servicecatalog_product",
 		Dependencies: []string{
 			"aws_servicecatalog_provisioning_artifact",
@@ -52,39 +52,38 @@ func RegisterSweepers() {
 		F: sweepProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioned_product", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioned_product",
 		Dependencies: []string{},
 		F:            sweepProvisionedProducts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_provisioning_artifact", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_provisioning_artifact",
 		Dependencies: []string{},
 		F:            sweepProvisioningArtifacts,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_service_action", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_service_action", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_service_action",
 		Dependencies: []string{},
 		F:            sweepServiceActions,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option_resource_association", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option_resource_association",
 		Dependencies: []string{},
 		F:            sweepTagOptionResourceAssociations,
 	})
 
-	resource.AddTestSweepers("aws_servicecatalog_tag_option", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_servicecatalog_tag_option", &sweep.Sweeper{
 		Name:         "aws_servicecatalog_tag_option",
 		Dependencies: []string{},
 		F:            sweepTagOptions,
 	})
 }
 
-func sweepBudgetResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepBudgetResourceAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -168,6 +167,7 @@ func sweepBudgetResourceAssociations(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Budget Resource Associations sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -175,8 +175,7 @@ func sweepBudgetResourceAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepConstraints(region string) error {
-	ctx := sweep.Context(region)
+func sweepConstraints(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -225,6 +224,7 @@ func sweepConstraints(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Constraints sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -232,8 +232,7 @@ func sweepConstraints(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepPrincipalPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepPrincipalPortfolioAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -282,6 +281,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Principal Portfolio Associations sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -289,8 +289,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProductPortfolioAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepProductPortfolioAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -357,6 +356,7 @@ func sweepProductPortfolioAssociations(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Product Portfolio Associations sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -364,8 +364,7 @@ func sweepProductPortfolioAssociations(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProducts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProducts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -404,6 +403,7 @@ func sweepProducts(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Products sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -411,8 +411,7 @@ func sweepProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisionedProducts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProvisionedProducts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -453,6 +452,7 @@ func sweepProvisionedProducts(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Provisioned Products sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -460,8 +460,7 @@ func sweepProvisionedProducts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepProvisioningArtifacts(region string) error {
-	ctx := sweep.Context(region)
+func sweepProvisioningArtifacts(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -513,6 +512,7 @@ func sweepProvisioningArtifacts(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Provisioning Artifacts sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -520,8 +520,7 @@ func sweepProvisioningArtifacts(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepServiceActions(region string) error {
-	ctx := sweep.Context(region)
+func sweepServiceActions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -556,6 +555,7 @@ func sweepServiceActions(region string) error {
 	}
 
 	if awsv2.SkipSweepError(errs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, errs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Service Actions sweep for %s: %s", region, errs)
 		return nil
 	}
@@ -563,8 +563,7 @@ func sweepServiceActions(region string) error {
 	return errs.ErrorOrNil()
 }
 
-func sweepTagOptionResourceAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepTagOptionResourceAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -622,6 +621,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 	}
 
 	if awsv2.SkipSweepError(sweeperErrs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, sweeperErrs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Tag Option Resource Associations sweep for %s: %s", region, sweeperErrs)
 		return nil
 	}
@@ -629,8 +629,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepTagOptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTagOptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 
 	if err != nil {
@@ -669,6 +668,7 @@ func sweepTagOptions(region string) error {
 	}
 
 	if awsv2.SkipSweepError(sweeperErrs.ErrorOrNil()) {
+		sweep.ReportSkipped(ctx, sweeperErrs.ErrorOrNil())
 		log.Printf("[WARN] Skipping Service Catalog Tag Options sweep for %s: %s", region, sweeperErrs)
 		return nil
 	}
@@ -4,13 +4,13 @@
 package servicediscovery
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -18,7 +18,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_service_discovery_http_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_http_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_http_namespace",
 		F:    sweepHTTPNamespaces,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_private_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_private_dns_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_private_dns_namespace",
 		F:    sweepPrivateDNSNamespaces,
 		Dependencies: []string{
@@ -34,7 +34,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_public_dns_namespace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_public_dns_namespace", &sweep.Sweeper{
 		Name: "aws_service_discovery_public_dns_namespace",
 		F:    sweepPublicDNSNamespaces,
 		Dependencies: []string{
@@ -42,14 +42,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_service_discovery_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_service_discovery_service", &sweep.Sweeper{
 		Name: "aws_service_discovery_service",
 		F:    sweepServices,
 	})
 }
 
-func sweepHTTPNamespaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepHTTPNamespaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -60,6 +59,7 @@ func sweepHTTPNamespaces(region string) error {
 	namespaces, err := findNamespacesByType(ctx, conn, awstypes.NamespaceTypeHttp)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Service Discovery HTTP Namespace sweep for %s: %s", region, err)
 		return nil
 	}
@@ -85,8 +85,7 @@ func sweepHTTPNamespaces(region string) error {
 	return nil
 }
 
-func sweepPrivateDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepPrivateDNSNamespaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -97,6 +96,7 @@ func sweepPrivateDNSNamespaces(region string) error {
 	namespaces, err := findNamespacesByType(ctx, conn, awstypes.NamespaceTypeDnsPrivate)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Service Discovery Private DNS Namespace sweep for %s: %s", region, err)
 		return nil
 	}
@@ -122,8 +122,7 @@ func sweepPrivateDNSNamespaces(region string) error {
 	return nil
 }
 
-func sweepPublicDNSNamespaces(region string) error {
-	ctx := sweep.Context(region)
+func sweepPublicDNSNamespaces(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -134,6 +133,7 @@ func sweepPublicDNSNamespaces(region string) error {
 	namespaces, err := findNamespacesByType(ctx, conn, awstypes.NamespaceTypeDnsPublic)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Service Discovery Public DNS Namespace sweep for %s: %s", region, err)
 		return nil
 	}
@@ -159,8 +159,7 @@ func sweepPublicDNSNamespaces(region string) error {
 	return nil
 }
 
-func sweepServices(region string) error {
-	ctx := sweep.Context(region)
+func sweepServices(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -172,6 +171,7 @@ func sweepServices(region string) error {
 	services, err := findServices(ctx, conn, input, tfslices.PredicateTrue[*awstypes.ServiceSummary]())
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Service Discovery Service sweep for %s: %s", region, err)
 		return nil
 	}
@@ -4,6 +4,7 @@
 package ses
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,36 +13,38 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ses/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &sweep.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &sweep.Sweeper{
 		Name: "aws_ses_domain_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeDomain)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeDomain))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &sweep.Sweeper{
 		Name: "aws_ses_email_identity",
-		F:    func(region string) error { return sweepIdentities(region, string(awstypes.IdentityTypeEmailAddress)) },
+		F: func(ctx context.Context, region string) error {
+			return sweepIdentities(ctx, region, string(awstypes.IdentityTypeEmailAddress))
+		},
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &sweep.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
 }
 
-func sweepConfigurationSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepConfigurationSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -53,6 +56,7 @@ func sweepConfigurationSets(region string) error {
 	for {
 		output, err := conn.ListConfigurationSets(ctx, input)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -88,8 +92,7 @@ func sweepConfigurationSets(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepIdentities(region, identityType string) error {
-	ctx := sweep.Context(region)
+func sweepIdentities(ctx context.Context, region, identityType string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -123,6 +126,7 @@ func sweepIdentities(region, identityType string) error {
 	}
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
 		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 	}
@@ -133,8 +137,7 @@ func sweepIdentities(region, identityType string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
-func sweepReceiptRuleSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepReceiptRuleSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -147,6 +150,7 @@ func sweepReceiptRuleSets(region string) error {
 	_, err = conn.SetActiveReceiptRuleSet(ctx, &ses.SetActiveReceiptRuleSetInput{})
 	// In some regions, this will return "InvalidAction" with no message
 	if awsv2.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, "InvalidAction") {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 		return nil
 	}
@@ -160,6 +164,7 @@ func sweepReceiptRuleSets(region string) error {
 	for {
 		output, err := conn.ListReceiptRuleSets(ctx, input)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
 			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
@@ -4,12 +4,12 @@
 package shield
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,12 +17,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_drt_access_log_bucket_association", &sweep.Sweeper{
 		Name: "aws_shield_drt_access_log_bucket_association",
 		F:    sweepDRTAccessLogBucketAssociations,
 	})
 
-	resource.AddTestSweepers("aws_shield_drt_access_role_arn_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_drt_access_role_arn_association", &sweep.Sweeper{
 		Name: "aws_shield_drt_access_role_arn_association",
 		F:    sweepDRTAccessRoleARNAssociations,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_shield_proactive_engagement", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_shield_proactive_engagement", &sweep.Sweeper{
 		Name: "aws_shield_proactive_engagement",
 		F:    sweepProactiveEngagements,
 		Dependencies: []string{
@@ -39,8 +39,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepDRTAccessLogBucketAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDRTAccessLogBucketAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -52,6 +51,7 @@ func sweepDRTAccessLogBucketAssociations(region string) error {
 	output, err := conn.DescribeDRTAccess(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Shield DRT Log Bucket Association sweep for %s: %s", region, err)
 		return nil
 	}
@@ -76,8 +76,7 @@ func sweepDRTAccessLogBucketAssociations(region string) error {
 	return nil
 }
 
-func sweepDRTAccessRoleARNAssociations(region string) error {
-	ctx := sweep.Context(region)
+func sweepDRTAccessRoleARNAssociations(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -89,6 +88,7 @@ func sweepDRTAccessRoleARNAssociations(region string) error {
 	output, err := conn.DescribeDRTAccess(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Shield DRT Role ARN Association sweep for %s: %s", region, err)
 		return nil
 	}
@@ -113,8 +113,7 @@ func sweepDRTAccessRoleARNAssociations(region string) error {
 	return nil
 }
 
-func sweepProactiveEngagements(region string) error {
-	ctx := sweep.Context(region)
+func sweepProactiveEngagements(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -126,6 +125,7 @@ func sweepProactiveEngagements(region string) error {
 	output, err := conn.DescribeSubscription(ctx, input)
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping Shield Proactive Engagement sweep for %s: %s", region, err)
 		return nil
 	}
@@ -4,23 +4,23 @@
 package sns
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_sns_platform_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_platform_application", &sweep.Sweeper{
 		Name: "aws_sns_platform_application",
 		F:    sweepPlatformApplications,
 	})
 
-	resource.AddTestSweepers("aws_sns_topic", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic", &sweep.Sweeper{
 		Name: "aws_sns_topic",
 		F:    sweepTopics,
 		Dependencies: []string{
@@ -45,14 +45,13 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_sns_topic_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_sns_topic_subscription", &sweep.Sweeper{
 		Name: "aws_sns_topic_subscription",
 		F:    sweepTopicSubscriptions,
 	})
 }
 
-func sweepPlatformApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepPlatformApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -66,6 +65,7 @@ func sweepPlatformApplications(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SNS Platform Application sweep for %s: %s", region, err)
 			return nil
 		}
@@ -92,8 +92,7 @@ func sweepPlatformApplications(region string) error {
 	return nil
 }
 
-func sweepTopics(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopics(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -107,6 +106,7 @@ func sweepTopics(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SNS Topic sweep for %s: %s", region, err)
 			return nil
 		}
@@ -133,8 +133,7 @@ func sweepTopics(region string) error {
 	return nil
 }
 
-func sweepTopicSubscriptions(region string) error {
-	ctx := sweep.Context(region)
+func sweepTopicSubscriptions(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -148,6 +147,7 @@ func sweepTopicSubscriptions(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SNS Topic Subscription sweep for %s: %s", region, err)
 			return nil
 		}
@@ -0,0 +1,422 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// sendCommandOutputMaxLength is the maximum number of characters of each
+	// target's standard output and standard error included in progress messages.
+	sendCommandOutputMaxLength = 1000
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                            `tfsdk:"comment"`
+	DocumentName    types.String                                            `tfsdk:"document_name"`
+	DocumentVersion types.String                                            `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListOfString                                    `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                            `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                            `tfsdk:"max_errors"`
+	Parameters      fwtypes.MapOfString                                     `tfsdk:"parameters"`
+	Targets         fwtypes.ListNestedObjectValueOf[sendCommandTargetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                             `tfsdk:"timeout"`
+}
+
+type sendCommandTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM document against managed nodes and waits for every command invocation to finish.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the SSM document to run, e.g. AWS-RunShellScript",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the SSM document to run, e.g. $LATEST, $DEFAULT or a version number",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "IDs of the managed nodes on which the command should run",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(path.MatchRoot("targets")),
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number of managed nodes, or percentage of targets, on which the command runs at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number of errors, or percentage of targets, allowed before the command stops being sent to further targets and is considered failed",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Parameters to pass to the SSM document",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for every command invocation to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sendCommandTargetModel](ctx),
+				Description: "Key-value pairs, such as tag:Environment, that select the managed nodes on which the command should run",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, e.g. InstanceIds or tag:Environment",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	if _, err := findDocumentByName(ctx, conn, documentName); retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Document Not Found",
+			fmt.Sprintf("SSM document %s was not found", documentName),
+		)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe Document",
+			fmt.Sprintf("Could not describe SSM document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	input := ssm.SendCommandInput{
+		Comment:         fwflex.StringFromFramework(ctx, config.Comment),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: fwflex.StringFromFramework(ctx, config.DocumentVersion),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, config.InstanceIDs),
+		MaxConcurrency:  fwflex.StringFromFramework(ctx, config.MaxConcurrency),
+		MaxErrors:       fwflex.StringFromFramework(ctx, config.MaxErrors),
+	}
+	if !config.Parameters.IsNull() {
+		input.Parameters = make(map[string][]string)
+		for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, config.Parameters) {
+			input.Parameters[k] = []string{v}
+		}
+	}
+	if !config.Targets.IsNull() {
+		resp.Diagnostics.Append(fwflex.Expand(ctx, config.Targets, &input.Targets)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending SSM command to run document %s...", documentName),
+	})
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send SSM command to run document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("SSM command %s sent, waiting for command invocations to finish...", commandID),
+	})
+
+	// Invocations are reported once, as soon as each target reaches a terminal status.
+	reported := make(map[string]awstypes.CommandInvocationStatus)
+	var failedTargets []string
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		for _, v := range invocations {
+			instanceID := aws.ToString(v.InstanceId)
+			if _, ok := reported[instanceID]; ok || !commandInvocationStatusIsTerminal(v.Status) {
+				continue
+			}
+			reported[instanceID] = v.Status
+
+			if v.Status != awstypes.CommandInvocationStatusSuccess {
+				failedTargets = append(failedTargets, instanceID)
+			}
+
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: commandInvocationProgressMessage(commandID, &v),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(5 * time.Second),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if command, ok := fr.Value.(*awstypes.Command); ok && command != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("SSM command %s is %s: %d of %d targets completed, %d errors", commandID, fr.Status, command.CompletedCount, command.TargetCount, command.ErrorCount),
+				})
+			}
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s did not finish within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := fmt.Sprintf("SSM command %s finished with status %s", commandID, failureErr.Status)
+			if command := fr.Value; command != nil {
+				message += fmt.Sprintf(": %d of %d targets failed (max errors: %s)", command.ErrorCount, command.TargetCount, aws.ToString(command.MaxErrors))
+			}
+			if len(failedTargets) > 0 {
+				message += fmt.Sprintf("\nFailed targets: %s", strings.Join(failedTargets, ", "))
+			}
+			resp.Diagnostics.AddError("Command Failed", message)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command Status",
+				fmt.Sprintf("SSM command %s entered unexpected status: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for SSM command %s to finish: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	// Failures within the max_errors threshold don't fail the command, but are worth surfacing.
+	if len(failedTargets) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Command Failed on Some Targets",
+			fmt.Sprintf("SSM command %s failed on %d targets within the error threshold: %s", commandID, len(failedTargets), strings.Join(failedTargets, ", ")),
+		)
+	}
+
+	message := fmt.Sprintf("SSM command %s completed successfully", commandID)
+	if command := fr.Value; command != nil {
+		message += fmt.Sprintf(" on %d of %d targets", command.CompletedCount-command.ErrorCount, command.TargetCount)
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":    commandID,
+		"document_name": documentName,
+	})
+}
+
+func commandInvocationStatusIsTerminal(status awstypes.CommandInvocationStatus) bool {
+	switch status {
+	case awstypes.CommandInvocationStatusSuccess,
+		awstypes.CommandInvocationStatusCancelled,
+		awstypes.CommandInvocationStatusFailed,
+		awstypes.CommandInvocationStatusTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
+// commandInvocationProgressMessage describes a finished command invocation, including the
+// truncated output of each of the document's plugins.
+// Plugin output is returned by ListCommandInvocations when details are requested, so no further API calls are made.
+func commandInvocationProgressMessage(commandID string, invocation *awstypes.CommandInvocation) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "SSM command %s on %s: %s", commandID, aws.ToString(invocation.InstanceId), invocation.Status)
+	if details := aws.ToString(invocation.StatusDetails); details != "" && details != string(invocation.Status) {
+		fmt.Fprintf(&sb, " (%s)", details)
+	}
+
+	for _, plugin := range invocation.CommandPlugins {
+		if len(invocation.CommandPlugins) > 1 {
+			fmt.Fprintf(&sb, "\n[%s] exit code %d", aws.ToString(plugin.Name), plugin.ResponseCode)
+		} else {
+			fmt.Fprintf(&sb, "\nexit code %d", plugin.ResponseCode)
+		}
+		if v := aws.ToString(plugin.Output); v != "" {
+			fmt.Fprintf(&sb, "\noutput:\n%s", truncateCommandOutput(v))
+		}
+	}
+
+	return sb.String()
+}
+
+func truncateCommandOutput(s string) string {
+	s = strings.TrimRight(s, "\n")
+	if runes := []rune(s); len(runes) > sendCommandOutputMaxLength {
+		return string(runes[:sendCommandOutputMaxLength]) + "\n... (truncated)"
+	}
+
+	return s
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, &input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError: err,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
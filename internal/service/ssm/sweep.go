@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -21,17 +20,17 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssm_default_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_default_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_default_patch_baseline",
 		F:    sweepDefaultPatchBaselines,
 	})
 
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &sweep.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_baseline", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_baseline", &sweep.Sweeper{
 		Name: "aws_ssm_patch_baseline",
 		F:    sweepPatchBaselines,
 		Dependencies: []string{
@@ -40,19 +39,18 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ssm_patch_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_patch_group", &sweep.Sweeper{
 		Name: "aws_ssm_patch_group",
 		F:    sweepPatchGroups,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &sweep.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
 }
 
-func sweepDefaultPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepDefaultPatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -65,6 +63,7 @@ func sweepDefaultPatchBaselines(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SSM Default Patch Baseline sweep for %s: %s", region, err)
 			return nil
 		}
@@ -113,8 +112,7 @@ func (s defaultPatchBaselineSweeper) Delete(ctx context.Context, optFns ...tfres
 	return sdkdiag.DiagnosticsError(diags)
 }
 
-func sweepMaintenanceWindows(region string) error {
-	ctx := sweep.Context(region)
+func sweepMaintenanceWindows(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -128,6 +126,7 @@ func sweepMaintenanceWindows(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SSM Maintenance Window sweep for %s: %s", region, err)
 			return nil
 		}
@@ -154,8 +153,7 @@ func sweepMaintenanceWindows(region string) error {
 	return nil
 }
 
-func sweepPatchBaselines(region string) error {
-	ctx := sweep.Context(region)
+func sweepPatchBaselines(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -168,6 +166,7 @@ func sweepPatchBaselines(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SSM Patch Baseline sweep for %s: %s", region, err)
 			return nil
 		}
@@ -195,8 +194,7 @@ func sweepPatchBaselines(region string) error {
 	return nil
 }
 
-func sweepPatchGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepPatchGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -210,6 +208,7 @@ func sweepPatchGroups(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SSM Patch Group sweep for %s: %s", region, err)
 			return nil
 		}
@@ -236,8 +235,7 @@ func sweepPatchGroups(region string) error {
 	return nil
 }
 
-func sweepResourceDataSyncs(region string) error {
-	ctx := sweep.Context(region)
+func sweepResourceDataSyncs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -251,6 +249,7 @@ func sweepResourceDataSyncs(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping SSM Resource Data Sync sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,6 +4,7 @@
 package ssoadmin
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -21,15 +21,15 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_ssoadmin_account_assignment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_account_assignment", &sweep.Sweeper{
 		Name: "aws_ssoadmin_account_assignment",
 		F:    sweepAccountAssignments,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_application", &sweep.Sweeper{
 		Name: "aws_ssoadmin_application",
 		F:    sweepApplications,
 	})
-	resource.AddTestSweepers("aws_ssoadmin_permission_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssoadmin_permission_set", &sweep.Sweeper{
 		Name: "aws_ssoadmin_permission_set",
 		F:    sweepPermissionSets,
 		Dependencies: []string{
@@ -38,8 +38,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepAccountAssignments(region string) error {
-	ctx := sweep.Context(region)
+func sweepAccountAssignments(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -77,6 +76,7 @@ func sweepAccountAssignments(region string) error {
 		for paginator.HasMorePages() {
 			page, err := paginator.NextPage(ctx)
 			if awsv2.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "ValidationException", "The operation is not supported for this Identity Center instance") {
+				sweep.ReportSkipped(ctx, err)
 				log.Printf("[WARN] Skipping SSO Account Assignment sweep for %s: %s", region, err)
 				return nil
 			}
@@ -130,8 +130,7 @@ func sweepAccountAssignments(region string) error {
 	return nil
 }
 
-func sweepApplications(region string) error {
-	ctx := sweep.Context(region)
+func sweepApplications(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -164,6 +163,7 @@ func sweepApplications(region string) error {
 		for paginator.HasMorePages() {
 			page, err := paginator.NextPage(ctx)
 			if awsv2.SkipSweepError(err) {
+				sweep.ReportSkipped(ctx, err)
 				log.Printf("[WARN] Skipping SSO Applications sweep for %s: %s", region, err)
 				return nil
 			}
@@ -192,8 +192,7 @@ func sweepApplications(region string) error {
 	return nil
 }
 
-func sweepPermissionSets(region string) error {
-	ctx := sweep.Context(region)
+func sweepPermissionSets(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -226,6 +225,7 @@ func sweepPermissionSets(region string) error {
 		for paginator.HasMorePages() {
 			page, err := paginator.NextPage(ctx)
 			if awsv2.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "ValidationException", "The operation is not supported for this Identity Center instance") {
+				sweep.ReportSkipped(ctx, err)
 				log.Printf("[WARN] Skipping SSO Permission Set sweep for %s: %s", region, err)
 				return nil
 			}
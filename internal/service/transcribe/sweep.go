@@ -4,18 +4,18 @@
 package transcribe
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_transcribe_language_model", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_language_model", &sweep.Sweeper{
 		Name: "aws_transcribe_language_model",
 		F:    sweepLanguageModels,
 		Dependencies: []string{
@@ -24,7 +24,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_medical_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_medical_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_medical_vocabulary",
 		F:    sweepMedicalVocabularies,
 		Dependencies: []string{
@@ -32,7 +32,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary",
 		F:    sweepVocabularies,
 		Dependencies: []string{
@@ -40,7 +40,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_transcribe_vocabulary_filter", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_transcribe_vocabulary_filter", &sweep.Sweeper{
 		Name: "aws_transcribe_vocabulary_filter",
 		F:    sweepVocabularyFilters,
 		Dependencies: []string{
@@ -49,8 +49,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepLanguageModels(region string) error {
-	ctx := sweep.Context(region)
+func sweepLanguageModels(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -66,6 +65,7 @@ func sweepLanguageModels(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Transcribe Language Models sweep for %s: %s", region, err)
 			return nil
 		}
@@ -93,8 +93,7 @@ func sweepLanguageModels(region string) error {
 	return nil
 }
 
-func sweepMedicalVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepMedicalVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -107,6 +106,7 @@ func sweepMedicalVocabularies(region string) error {
 	for {
 		out, err := conn.ListMedicalVocabularies(ctx, in)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Transcribe Medical Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
@@ -138,8 +138,7 @@ func sweepMedicalVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularies(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularies(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -152,6 +151,7 @@ func sweepVocabularies(region string) error {
 	for {
 		out, err := conn.ListVocabularies(ctx, in)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Transcribe Vocabularies sweep for %s: %s", region, err)
 			return nil
 		}
@@ -183,8 +183,7 @@ func sweepVocabularies(region string) error {
 	return nil
 }
 
-func sweepVocabularyFilters(region string) error {
-	ctx := sweep.Context(region)
+func sweepVocabularyFilters(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -197,6 +196,7 @@ func sweepVocabularyFilters(region string) error {
 	for {
 		out, err := conn.ListVocabularyFilters(ctx, in)
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping Transcribe Vocabulary Filter sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,12 +4,12 @@
 package verifiedpermissions
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -17,14 +17,13 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_verifiedpermissions_policy_store", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_verifiedpermissions_policy_store", &sweep.Sweeper{
 		Name: "aws_verifiedpermissions_policy_store",
 		F:    sweepPolicyStores,
 	})
 }
 
-func sweepPolicyStores(region string) error {
-	ctx := sweep.Context(region)
+func sweepPolicyStores(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -40,6 +39,7 @@ func sweepPolicyStores(region string) error {
 		page, err := pages.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping VerifiedPermissions Policy Stores sweep for %s: %s", region, err)
 			return nil
 		}
@@ -4,19 +4,19 @@
 package waf
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/waf"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_waf_byte_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_byte_match_set", &sweep.Sweeper{
 		Name: "aws_waf_byte_match_set",
 		F:    sweepByteMatchSet,
 		Dependencies: []string{
@@ -26,7 +26,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_geo_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_geo_match_set", &sweep.Sweeper{
 		Name: "aws_waf_geo_match_set",
 		F:    sweepGeoMatchSet,
 		Dependencies: []string{
@@ -36,7 +36,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_ipset", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_ipset", &sweep.Sweeper{
 		Name: "aws_waf_ipset",
 		F:    sweepIPSet,
 		Dependencies: []string{
@@ -46,7 +46,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rate_based_rule", &sweep.Sweeper{
 		Name: "aws_waf_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_match_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_match_set",
 		F:    sweepRegexMatchSet,
 		Dependencies: []string{
@@ -65,7 +65,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_regex_pattern_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_regex_pattern_set", &sweep.Sweeper{
 		Name: "aws_waf_regex_pattern_set",
 		F:    sweepRegexPatternSet,
 		Dependencies: []string{
@@ -75,7 +75,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule_group", &sweep.Sweeper{
 		Name: "aws_waf_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -83,7 +83,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_rule", &sweep.Sweeper{
 		Name: "aws_waf_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -92,7 +92,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_size_constraint_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_size_constraint_set", &sweep.Sweeper{
 		Name: "aws_waf_size_constraint_set",
 		F:    sweepSizeConstraintSet,
 		Dependencies: []string{
@@ -102,7 +102,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_sql_injection_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_sql_injection_match_set", &sweep.Sweeper{
 		Name: "aws_waf_sql_injection_match_set",
 		F:    sweepSQLInjectionMatchSet,
 		Dependencies: []string{
@@ -112,12 +112,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_waf_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_web_acl", &sweep.Sweeper{
 		Name: "aws_waf_web_acl",
 		F:    sweepWebACLs,
 	})
 
-	resource.AddTestSweepers("aws_waf_xss_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_waf_xss_match_set", &sweep.Sweeper{
 		Name: "aws_waf_xss_match_set",
 		F:    sweepXSSMatchSet,
 		Dependencies: []string{
@@ -128,8 +128,7 @@ func RegisterSweepers() {
 	})
 }
 
-func sweepByteMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepByteMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -164,6 +163,7 @@ func sweepByteMatchSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Byte Match Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -181,8 +181,7 @@ func sweepByteMatchSet(region string) error {
 	return nil
 }
 
-func sweepGeoMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepGeoMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -217,6 +216,7 @@ func sweepGeoMatchSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Geo Match Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -234,8 +234,7 @@ func sweepGeoMatchSet(region string) error {
 	return nil
 }
 
-func sweepIPSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -270,6 +269,7 @@ func sweepIPSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF IP Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -287,8 +287,7 @@ func sweepIPSet(region string) error {
 	return nil
 }
 
-func sweepRateBasedRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRateBasedRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -323,6 +322,7 @@ func sweepRateBasedRules(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Rate Based Rule sweep for %s: %s", region, err)
 		return nil
 	}
@@ -340,8 +340,7 @@ func sweepRateBasedRules(region string) error {
 	return nil
 }
 
-func sweepRegexMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegexMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -376,6 +375,7 @@ func sweepRegexMatchSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Regex Match Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -393,8 +393,7 @@ func sweepRegexMatchSet(region string) error {
 	return nil
 }
 
-func sweepRegexPatternSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepRegexPatternSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -429,6 +428,7 @@ func sweepRegexPatternSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Regex Pattern Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -446,8 +446,7 @@ func sweepRegexPatternSet(region string) error {
 	return nil
 }
 
-func sweepRuleGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepRuleGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -482,6 +481,7 @@ func sweepRuleGroups(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Rule Group sweep for %s: %s", region, err)
 		return nil
 	}
@@ -499,8 +499,7 @@ func sweepRuleGroups(region string) error {
 	return nil
 }
 
-func sweepRules(region string) error {
-	ctx := sweep.Context(region)
+func sweepRules(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -535,6 +534,7 @@ func sweepRules(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Rule sweep for %s: %s", region, err)
 		return nil
 	}
@@ -552,8 +552,7 @@ func sweepRules(region string) error {
 	return nil
 }
 
-func sweepSizeConstraintSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepSizeConstraintSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -588,6 +587,7 @@ func sweepSizeConstraintSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Size Constraint Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -605,8 +605,7 @@ func sweepSizeConstraintSet(region string) error {
 	return nil
 }
 
-func sweepSQLInjectionMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepSQLInjectionMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -641,6 +640,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF SQL Injection Match Set sweep for %s: %s", region, err)
 		return nil
 	}
@@ -658,8 +658,7 @@ func sweepSQLInjectionMatchSet(region string) error {
 	return nil
 }
 
-func sweepWebACLs(region string) error {
-	ctx := sweep.Context(region)
+func sweepWebACLs(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -694,6 +693,7 @@ func sweepWebACLs(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF Web ACL sweep for %s: %s", region, err)
 		return nil
 	}
@@ -711,8 +711,7 @@ func sweepWebACLs(region string) error {
 	return nil
 }
 
-func sweepXSSMatchSet(region string) error {
-	ctx := sweep.Context(region)
+func sweepXSSMatchSet(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -747,6 +746,7 @@ func sweepXSSMatchSet(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WAF XSS Match Set sweep for %s: %s", region, err)
 		return nil
 	}
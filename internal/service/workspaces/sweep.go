@@ -4,18 +4,18 @@
 package workspaces
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_workspaces_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_directory", &sweep.Sweeper{
 		Name: "aws_workspaces_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -24,19 +24,18 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_workspaces_ip_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_ip_group", &sweep.Sweeper{
 		Name: "aws_workspaces_ip_group",
 		F:    sweepIPGroups,
 	})
 
-	resource.AddTestSweepers("aws_workspaces_workspace", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_workspaces_workspace", &sweep.Sweeper{
 		Name: "aws_workspaces_workspace",
 		F:    sweepWorkspace,
 	})
 }
 
-func sweepDirectories(region string) error {
-	ctx := sweep.Context(region)
+func sweepDirectories(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -50,6 +49,7 @@ func sweepDirectories(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping WorkSpaces Directory sweep for %s: %s", region, err)
 			return nil
 		}
@@ -76,8 +76,7 @@ func sweepDirectories(region string) error {
 	return nil
 }
 
-func sweepIPGroups(region string) error {
-	ctx := sweep.Context(region)
+func sweepIPGroups(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -103,6 +102,7 @@ func sweepIPGroups(region string) error {
 	})
 
 	if awsv2.SkipSweepError(err) {
+		sweep.ReportSkipped(ctx, err)
 		log.Printf("[WARN] Skipping WorkSpaces IP Group sweep for %s: %s", region, err)
 		return nil
 	}
@@ -120,8 +120,7 @@ func sweepIPGroups(region string) error {
 	return nil
 }
 
-func sweepWorkspace(region string) error {
-	ctx := sweep.Context(region)
+func sweepWorkspace(ctx context.Context, region string) error {
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
 	if err != nil {
 		return fmt.Errorf("getting client: %w", err)
@@ -135,6 +134,7 @@ func sweepWorkspace(region string) error {
 		page, err := paginator.NextPage(ctx)
 
 		if awsv2.SkipSweepError(err) {
+			sweep.ReportSkipped(ctx, err)
 			log.Printf("[WARN] Skipping WorkSpaces Workspace sweep for %s: %s", region, err)
 			return nil
 		}
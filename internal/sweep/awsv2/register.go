@@ -4,19 +4,18 @@
 package awsv2
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
+	sweep.AddServiceTestSweepers(name, sweep.ServicePackageNameOf(f), &sweep.Sweeper{
 		Name: name,
-		F: func(region string) error {
-			ctx := sweep.Context(region)
+		F: func(ctx context.Context, region string) error {
 			ctx = log.WithResourceType(ctx, name)
 
 			client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -27,6 +26,7 @@ func Register(name string, f sweep.SweeperFn, dependencies ...string) {
 			sweepResources, err := f(ctx, client)
 
 			if SkipSweepError(err) {
+				sweep.ReportSkipped(ctx, err)
 				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
 					"error": err.Error(),
 				})
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// CreatedAtAttributeNames are the names of the resource attributes that may hold a resource's creation time, in order of preference.
+var CreatedAtAttributeNames = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	names.AttrCreateTime,
+	"create_date",
+	"create_timestamp",
+	"created_timestamp",
+	"creation_timestamp",
+}
+
+// Filter selects the resources that sweepers delete.
+// A resource is deleted only if it matches all of the filter's criteria.
+type Filter struct {
+	// NamePrefixes are the prefixes that a resource's name must have one of.
+	NamePrefixes []string
+
+	// Tags are the tags that a resource must have.
+	// An empty value matches any value for the tag key.
+	Tags map[string]string
+
+	// MinAge is the minimum time since a resource was created.
+	MinAge time.Duration
+}
+
+// Attributes are the attributes of a resource that are used to filter sweeps.
+type Attributes struct {
+	// Name is the resource's name, or its identifier if it has no name.
+	Name string
+
+	// Tags are the resource's tags.
+	// A nil value indicates that the resource's tags are not known.
+	Tags map[string]string
+
+	// CreatedAt is when the resource was created.
+	// The zero value indicates that the creation time is not known.
+	CreatedAt time.Time
+}
+
+// FromEnv returns the Filter configured via environment variables.
+// A nil value is returned if no filter is configured.
+func FromEnv() (*Filter, error) {
+	var f Filter
+
+	if v := os.Getenv(envvar.SweepNamePrefixes); v != "" {
+		for prefix := range strings.SplitSeq(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				f.NamePrefixes = append(f.NamePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepTags); v != "" {
+		f.Tags = make(map[string]string)
+		for tag := range strings.SplitSeq(v, ",") {
+			key, value, _ := strings.Cut(strings.TrimSpace(tag), "=")
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: invalid tag %q, expected KEY or KEY=VALUE", envvar.SweepTags, tag)
+			}
+			f.Tags[key] = value
+		}
+	}
+
+	if v := os.Getenv(envvar.SweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepMinAge, err)
+		}
+		f.MinAge = d
+	}
+
+	if f.IsEmpty() {
+		return nil, nil
+	}
+
+	return &f, nil
+}
+
+// IsEmpty returns whether the filter matches all resources.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.NamePrefixes) == 0 && len(f.Tags) == 0 && f.MinAge == 0)
+}
+
+// NeedsTags returns whether the filter matches on resource tags.
+func (f *Filter) NeedsTags() bool {
+	return f != nil && len(f.Tags) > 0
+}
+
+// NeedsCreatedAt returns whether the filter matches on resource creation time.
+func (f *Filter) NeedsCreatedAt() bool {
+	return f != nil && f.MinAge > 0
+}
+
+// Match returns whether the resource with the specified attributes is to be swept.
+// Resources whose tags or creation time are needed, but not known, don't match.
+func (f *Filter) Match(attrs Attributes, now time.Time) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		if !matchesAnyPrefix(attrs.Name, f.NamePrefixes) {
+			return false
+		}
+	}
+
+	for key, want := range f.Tags {
+		got, ok := attrs.Tags[key]
+		if !ok || (want != "" && got != want) {
+			return false
+		}
+	}
+
+	if f.MinAge > 0 {
+		if attrs.CreatedAt.IsZero() || now.Sub(attrs.CreatedAt) < f.MinAge {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns a human-readable description of the filter, for logging.
+func (f *Filter) String() string {
+	if f.IsEmpty() {
+		return "<none>"
+	}
+
+	var parts []string
+	if len(f.NamePrefixes) > 0 {
+		parts = append(parts, fmt.Sprintf("name prefixes %s", strings.Join(f.NamePrefixes, ", ")))
+	}
+	if len(f.Tags) > 0 {
+		parts = append(parts, fmt.Sprintf("tags %v", f.Tags))
+	}
+	if f.MinAge > 0 {
+		parts = append(parts, fmt.Sprintf("minimum age %s", f.MinAge))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// ParseTime returns the time represented by a creation time attribute value.
+func ParseTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, !v.IsZero()
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return ParseTime(*v)
+	case *string:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return ParseTime(*v)
+	case string:
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func matchesAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		filter *Filter
+		attrs  Attributes
+		want   bool
+	}{
+		{
+			name:  "nil filter",
+			attrs: Attributes{Name: "anything"},
+			want:  true,
+		},
+		{
+			name:   "name prefix match",
+			filter: &Filter{NamePrefixes: []string{"tf-acc-test-", "tf-test-"}},
+			attrs:  Attributes{Name: "tf-test-1234"},
+			want:   true,
+		},
+		{
+			name:   "name prefix no match",
+			filter: &Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			attrs:  Attributes{Name: "production"},
+			want:   false,
+		},
+		{
+			name:   "tag value match",
+			filter: &Filter{Tags: map[string]string{"CreatedBy": "acctest"}},
+			attrs:  Attributes{Tags: map[string]string{"CreatedBy": "acctest", "Name": "example"}},
+			want:   true,
+		},
+		{
+			name:   "tag value no match",
+			filter: &Filter{Tags: map[string]string{"CreatedBy": "acctest"}},
+			attrs:  Attributes{Tags: map[string]string{"CreatedBy": "fixtures"}},
+			want:   false,
+		},
+		{
+			name:   "tag key only match",
+			filter: &Filter{Tags: map[string]string{"CreatedBy": ""}},
+			attrs:  Attributes{Tags: map[string]string{"CreatedBy": "fixtures"}},
+			want:   true,
+		},
+		{
+			name:   "tags unknown",
+			filter: &Filter{Tags: map[string]string{"CreatedBy": ""}},
+			attrs:  Attributes{Name: "tf-acc-test-1234"},
+			want:   false,
+		},
+		{
+			name:   "old enough",
+			filter: &Filter{MinAge: 6 * time.Hour},
+			attrs:  Attributes{CreatedAt: now.Add(-7 * time.Hour)},
+			want:   true,
+		},
+		{
+			name:   "too new",
+			filter: &Filter{MinAge: 6 * time.Hour},
+			attrs:  Attributes{CreatedAt: now.Add(-time.Hour)},
+			want:   false,
+		},
+		{
+			name:   "creation time unknown",
+			filter: &Filter{MinAge: 6 * time.Hour},
+			attrs:  Attributes{Name: "tf-acc-test-1234"},
+			want:   false,
+		},
+		{
+			name: "all criteria",
+			filter: &Filter{
+				NamePrefixes: []string{"tf-acc-test-"},
+				Tags:         map[string]string{"CreatedBy": "acctest"},
+				MinAge:       time.Hour,
+			},
+			attrs: Attributes{
+				Name:      "tf-acc-test-1234",
+				Tags:      map[string]string{"CreatedBy": "acctest"},
+				CreatedAt: now.Add(-2 * time.Hour),
+			},
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.filter.Match(testCase.attrs, now); got != testCase.want {
+				t.Errorf("Match() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	testCases := []struct {
+		name    string
+		env     map[string]string
+		want    *Filter
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "all",
+			env: map[string]string{
+				envvar.SweepNamePrefixes: "tf-acc-test-, tf-test-",
+				envvar.SweepTags:         "CreatedBy=acctest,Ephemeral",
+				envvar.SweepMinAge:       "6h",
+			},
+			want: &Filter{
+				NamePrefixes: []string{"tf-acc-test-", "tf-test-"},
+				Tags:         map[string]string{"CreatedBy": "acctest", "Ephemeral": ""},
+				MinAge:       6 * time.Hour,
+			},
+		},
+		{
+			name:    "invalid tag",
+			env:     map[string]string{envvar.SweepTags: "=acctest"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid age",
+			env:     map[string]string{envvar.SweepMinAge: "6 hours"},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for _, k := range []string{envvar.SweepNamePrefixes, envvar.SweepTags, envvar.SweepMinAge} {
+				t.Setenv(k, testCase.env[k])
+			}
+
+			got, err := FromEnv()
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("FromEnv() error = %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	want := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s := "2026-01-02T03:04:05Z"
+
+	for _, v := range []any{want, &want, s, &s} {
+		if got, ok := ParseTime(v); !ok || !got.Equal(want) {
+			t.Errorf("ParseTime(%T) = %s, %t, want %s", v, got, ok, want)
+		}
+	}
+
+	for _, v := range []any{nil, "", "yesterday", (*string)(nil), 1234} {
+		if _, ok := ParseTime(v); ok {
+			t.Errorf("ParseTime(%#v): expected not ok", v)
+		}
+	}
+}
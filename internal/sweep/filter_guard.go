@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+// Many sweepers delete resources directly instead of using SweepOrchestrator, and so cannot be filtered.
+// So that filtering never fails open, when a filter is set API operations that may modify resources
+// are only allowed while deleting a resource that matched the filter.
+
+// readOnlyOperationPrefixes are the prefixes of API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+// unfilteredServiceIDs are the services whose API operations are always allowed, e.g. to obtain credentials.
+var unfilteredServiceIDs = []string{
+	"SSO",
+	"SSO OIDC",
+	"STS",
+}
+
+type filterMatchedKey struct{}
+
+// withFilterMatched returns a context used to delete a resource that matched the filter.
+func withFilterMatched(ctx context.Context) context.Context {
+	return context.WithValue(ctx, filterMatchedKey{}, true)
+}
+
+// checkFilteredOperation returns an error if the specified API operation is not allowed by the filter.
+func checkFilteredOperation(ctx context.Context, f *filter.Filter, serviceID, operationName string) error {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	if v, ok := ctx.Value(filterMatchedKey{}).(bool); ok && v {
+		return nil
+	}
+
+	if slices.Contains(unfilteredServiceIDs, serviceID) {
+		return nil
+	}
+
+	if slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operationName, prefix)
+	}) {
+		return nil
+	}
+
+	return fmt.Errorf("%s %s not allowed: sweeper deletes resources without using SweepOrchestrator and cannot be filtered (%s)", serviceID, operationName, f)
+}
+
+// filterGuardAPIOption is an AWS SDK for Go v2 API client option that rejects API operations not allowed by the filter.
+func filterGuardAPIOption(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("SweepFilterGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		// Any error configuring the filter is returned when the sweeper's client is created.
+		if f, err := sweepFilter(); err == nil {
+			if err := checkFilteredOperation(ctx, f, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
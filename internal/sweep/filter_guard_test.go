@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+)
+
+func TestCheckFilteredOperation(t *testing.T) {
+	t.Parallel()
+
+	f := &filter.Filter{
+		NamePrefixes: []string{"tf-acc-test-"},
+	}
+
+	testCases := map[string]struct {
+		filter        *filter.Filter
+		matched       bool
+		serviceID     string
+		operationName string
+		expectError   bool
+	}{
+		"no filter": {
+			serviceID:     "IAM",
+			operationName: "DeleteGroup",
+		},
+		"read-only operation": {
+			filter:        f,
+			serviceID:     "IAM",
+			operationName: "ListGroups",
+		},
+		"delete": {
+			filter:        f,
+			serviceID:     "IAM",
+			operationName: "DeleteGroup",
+			expectError:   true,
+		},
+		"modify": {
+			filter:        f,
+			serviceID:     "EC2",
+			operationName: "ModifyInstanceAttribute",
+			expectError:   true,
+		},
+		"delete matched resource": {
+			filter:        f,
+			matched:       true,
+			serviceID:     "IAM",
+			operationName: "DeleteGroup",
+		},
+		"credentials": {
+			filter:        f,
+			serviceID:     "STS",
+			operationName: "AssumeRole",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			if testCase.matched {
+				ctx = withFilterMatched(ctx)
+			}
+
+			err := checkFilteredOperation(ctx, testCase.filter, testCase.serviceID, testCase.operationName)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("checkFilteredOperation() error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -111,6 +112,51 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// FilterAttributes returns the attributes used to filter the sweep.
+// Only the attributes set by the sweeper are used; to filter on tags or creation time
+// the sweeper must set the "tags" attribute or a creation time attribute (e.g. "created_at").
+func (sr *sweepResource) FilterAttributes(ctx context.Context, f *filter.Filter) (filter.Attributes, error) {
+	var attrs filter.Attributes
+
+	values := make(map[string]any, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		values[attr.path] = attr.value
+	}
+
+	for _, k := range []string{names.AttrName, names.AttrID, names.AttrARN} {
+		if v, ok := values[k]; ok {
+			var name string
+			switch v := v.(type) {
+			case string:
+				name = v
+			case *string:
+				name = aws.ToString(v)
+			}
+
+			if name != "" {
+				attrs.Name = name
+				break
+			}
+		}
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if v, ok := values[k].(map[string]string); ok {
+			attrs.Tags = v
+			break
+		}
+	}
+
+	for _, k := range filter.CreatedAtAttributeNames {
+		if t, ok := filter.ParseTime(values[k]); ok {
+			attrs.CreatedAt = t
+			break
+		}
+	}
+
+	return attrs, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
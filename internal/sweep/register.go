@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// registeredSweeper is a sweeper registered for use by the sweeper scheduler.
+type registeredSweeper struct {
+	*resource.Sweeper
+
+	servicePackageName string
+}
+
+var (
+	registeredSweepersLock sync.Mutex
+	registeredSweepers     = make(map[string]registeredSweeper)
+)
+
+// Sweeper is a sweeper to register with AddTestSweepers.
+type Sweeper struct {
+	// Name of the sweeper.
+	Name string
+
+	// Dependencies are the names of the sweepers that must be run before this sweeper.
+	Dependencies []string
+
+	// F sweeps the specified Region.
+	// The context records the sweeper's resources and skips in the current report, if any.
+	F func(ctx context.Context, region string) error
+}
+
+// AddTestSweepers registers a sweeper with both the acceptance testing framework's sweeper runner
+// and the dependency-aware sweeper scheduler.
+// The sweeper's service package is the package its sweeper function is defined in.
+func AddTestSweepers(name string, s *Sweeper) {
+	AddServiceTestSweepers(name, ServicePackageNameOf(s.F), s)
+}
+
+// AddServiceTestSweepers registers a sweeper for the specified service package with both the acceptance testing framework's
+// sweeper runner and the dependency-aware sweeper scheduler.
+func AddServiceTestSweepers(name, servicePackageName string, s *Sweeper) {
+	sweeper := &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F:            reportingSweeperFunc(name, s.F),
+	}
+
+	registeredSweepersLock.Lock()
+	defer registeredSweepersLock.Unlock()
+
+	registeredSweepers[name] = registeredSweeper{
+		Sweeper:            sweeper,
+		servicePackageName: servicePackageName,
+	}
+
+	resource.AddTestSweepers(name, sweeper)
+}
+
+// reportingSweeperFunc returns a sweeper function that runs f with a context recording the sweeper in the current report, if any.
+func reportingSweeperFunc(name string, f func(ctx context.Context, region string) error) func(string) error {
+	return func(region string) error {
+		ctx := reportContext(Context(region), currentReport.Load(), region, name)
+
+		return f(ctx, region)
+	}
+}
+
+// ServicePackageNameOf returns the name of the service package that the specified function is defined in.
+// An empty string is returned if the function isn't defined in a service package.
+func ServicePackageNameOf(f any) string {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	// e.g. "github.com/hashicorp/terraform-provider-aws/internal/service/ec2.sweepVPCs".
+	_, after, ok := strings.Cut(fn.Name(), "/internal/service/")
+	if !ok {
+		return ""
+	}
+	name, _, _ := strings.Cut(after, ".")
+
+	return name
+}
@@ -0,0 +1,396 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Sweeper and resource statuses recorded in a Report.
+const (
+	ReportStatusDeleted  = "deleted"
+	ReportStatusFailed   = "failed"
+	ReportStatusFiltered = "filtered"
+	ReportStatusPassed   = "passed"
+	ReportStatusSkipped  = "skipped"
+)
+
+// Report is a machine-readable record of a sweeper run.
+type Report struct {
+	lock sync.Mutex
+
+	StartedAt      time.Time       `json:"started_at"`
+	ElapsedSeconds float64         `json:"elapsed_seconds"`
+	Regions        []*RegionReport `json:"regions"`
+}
+
+// RegionReport records the sweepers run in a Region.
+type RegionReport struct {
+	Region   string           `json:"region"`
+	Sweepers []*SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport records a single sweeper's run in a Region.
+type SweeperReport struct {
+	Name           string            `json:"name"`
+	Service        string            `json:"service,omitempty"`
+	Status         string            `json:"status"`
+	SkipReason     string            `json:"skip_reason,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	StartedAt      time.Time         `json:"started_at"`
+	ElapsedSeconds float64           `json:"elapsed_seconds"`
+	Resources      []*ResourceReport `json:"resources"`
+}
+
+// ResourceReport records a resource discovered by a sweeper and the attempt to delete it.
+type ResourceReport struct {
+	Name           string  `json:"name,omitempty"`
+	Status         string  `json:"status"`
+	Retries        int     `json:"retries"`
+	Error          string  `json:"error,omitempty"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+
+	retries atomic.Int32
+}
+
+// NewReport returns a new, empty Report.
+func NewReport() *Report {
+	return &Report{
+		StartedAt: time.Now(),
+		Regions:   make([]*RegionReport, 0),
+	}
+}
+
+// currentReport is the report being recorded, if any.
+var currentReport atomic.Pointer[Report]
+
+// sweeper returns the record of the specified sweeper in the specified Region, adding it if not present.
+func (r *Report) sweeper(region, name string) *SweeperReport {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var regionReport *RegionReport
+	for _, v := range r.Regions {
+		if v.Region == region {
+			regionReport = v
+			break
+		}
+	}
+	if regionReport == nil {
+		regionReport = &RegionReport{
+			Region:   region,
+			Sweepers: make([]*SweeperReport, 0),
+		}
+		r.Regions = append(r.Regions, regionReport)
+	}
+
+	for _, v := range regionReport.Sweepers {
+		if v.Name == name {
+			return v
+		}
+	}
+
+	sweeperReport := &SweeperReport{
+		Name:      name,
+		Status:    ReportStatusPassed,
+		StartedAt: time.Now(),
+		Resources: make([]*ResourceReport, 0),
+	}
+	regionReport.Sweepers = append(regionReport.Sweepers, sweeperReport)
+
+	return sweeperReport
+}
+
+// sweeperDone records the outcome of running a sweeper.
+func (r *Report) sweeperDone(region, name, servicePackageName string, start time.Time, err error) {
+	sweeperReport := r.sweeper(region, name)
+
+	r.update(func() {
+		sweeperReport.Service = servicePackageName
+		sweeperReport.StartedAt = start
+		sweeperReport.ElapsedSeconds = time.Since(start).Seconds()
+
+		if err != nil {
+			sweeperReport.Status = ReportStatusFailed
+			sweeperReport.Error = err.Error()
+		}
+	})
+}
+
+// finish records the end of the run.
+func (r *Report) finish() {
+	r.update(func() {
+		r.ElapsedSeconds = time.Since(r.StartedAt).Seconds()
+	})
+}
+
+// update calls f with the report locked.
+func (r *Report) update(f func()) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	f()
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, region := range r.Regions {
+		for _, sweeper := range region.Sweepers {
+			for _, resource := range sweeper.Resources {
+				resource.Retries = int(resource.retries.Load())
+			}
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	Time       string           `xml:"time,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnit writes the report as JUnit XML.
+// Each Region is a test suite and each sweeper a test case.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	suites := junitTestSuites{
+		Name: "sweepers",
+		Time: junitTime(r.ElapsedSeconds),
+	}
+
+	for _, region := range r.Regions {
+		suite := junitTestSuite{
+			Name: region.Region,
+		}
+
+		for _, sweeper := range region.Sweepers {
+			testCase := junitTestCase{
+				Name:      sweeper.Name,
+				ClassName: sweeper.Service,
+				Time:      junitTime(sweeper.ElapsedSeconds),
+			}
+
+			if suite.Timestamp == "" || sweeper.StartedAt.Format(time.RFC3339) < suite.Timestamp {
+				suite.Timestamp = sweeper.StartedAt.Format(time.RFC3339)
+			}
+
+			switch sweeper.Status {
+			case ReportStatusFailed:
+				testCase.Failure = &junitMessage{Message: sweeper.Error}
+				suite.Failures++
+			case ReportStatusSkipped:
+				testCase.Skipped = &junitMessage{Message: sweeper.SkipReason}
+				suite.Skipped++
+			}
+
+			counts := make(map[string]int)
+			retries := 0
+			for _, resource := range sweeper.Resources {
+				counts[resource.Status]++
+				retries += int(resource.retries.Load())
+			}
+			testCase.SystemOut = fmt.Sprintf("resources: %d, deleted: %d, failed: %d, filtered: %d, retries: %d",
+				len(sweeper.Resources), counts[ReportStatusDeleted], counts[ReportStatusFailed], counts[ReportStatusFiltered], retries)
+
+			suite.TestCases = append(suite.TestCases, testCase)
+			suite.Tests++
+		}
+
+		suites.TestSuites = append(suites.TestSuites, suite)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func junitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
+
+type sweeperReportKey struct{}
+
+// sweeperContext is the value stored in a context by reportContext.
+type sweeperContext struct {
+	report  *Report
+	sweeper *SweeperReport
+}
+
+func reportContext(ctx context.Context, r *Report, region, name string) context.Context {
+	if r == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, sweeperReportKey{}, &sweeperContext{
+		report:  r,
+		sweeper: r.sweeper(region, name),
+	})
+}
+
+// ReportSkipped records that the sweeper running with the specified context was skipped.
+func ReportSkipped(ctx context.Context, err error) {
+	v, ok := ctx.Value(sweeperReportKey{}).(*sweeperContext)
+	if !ok {
+		return
+	}
+
+	v.report.update(func() {
+		// A sweeper that has already failed is not marked as skipped.
+		if v.sweeper.Status == ReportStatusFailed {
+			return
+		}
+
+		v.sweeper.Status = ReportStatusSkipped
+		if err != nil {
+			v.sweeper.SkipReason = err.Error()
+		}
+	})
+}
+
+type resourceReportKey struct{}
+
+// resourceContext is the value stored in a context by reportResource.
+type resourceContext struct {
+	report   *Report
+	resource *ResourceReport
+}
+
+// reportResource records a resource discovered by the sweeper running with the specified context.
+// The returned context counts API request retries made while deleting the resource.
+func reportResource(ctx context.Context, sweepable Sweepable) (context.Context, *resourceContext) {
+	v, ok := ctx.Value(sweeperReportKey{}).(*sweeperContext)
+	if !ok {
+		return ctx, nil
+	}
+
+	resourceReport := &ResourceReport{}
+	if sweepable, ok := sweepable.(Filterable); ok {
+		// A nil filter never causes the resource to be read.
+		if attrs, err := sweepable.FilterAttributes(ctx, nil); err == nil {
+			resourceReport.Name = attrs.Name
+		}
+	}
+
+	v.report.update(func() {
+		v.sweeper.Resources = append(v.sweeper.Resources, resourceReport)
+	})
+
+	rc := &resourceContext{
+		report:   v.report,
+		resource: resourceReport,
+	}
+
+	return context.WithValue(ctx, resourceReportKey{}, rc), rc
+}
+
+// done records the outcome of sweeping a resource.
+func (rc *resourceContext) done(start time.Time, filtered bool, err error) {
+	if rc == nil {
+		return
+	}
+
+	rc.report.update(func() {
+		rc.resource.ElapsedSeconds = time.Since(start).Seconds()
+
+		switch {
+		case err != nil:
+			rc.resource.Status = ReportStatusFailed
+			rc.resource.Error = err.Error()
+		case filtered:
+			rc.resource.Status = ReportStatusFiltered
+		default:
+			rc.resource.Status = ReportStatusDeleted
+		}
+	})
+}
+
+// reportRetriesAPIOption is an AWS SDK for Go v2 API client option that counts request retries
+// against the resource being swept.
+func reportRetriesAPIOption(stack *middleware.Stack) error {
+	mw := middleware.FinalizeMiddlewareFunc("SweepReportRetries", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if v, ok := ctx.Value(resourceReportKey{}).(*resourceContext); ok {
+			if results, ok := retry.GetAttemptResults(metadata); ok && len(results.Results) > 1 {
+				v.resource.retries.Add(int32(len(results.Results) - 1))
+			}
+		}
+
+		return out, metadata, err
+	})
+
+	// The attempt results are available once the retry middleware has completed.
+	if _, ok := stack.Finalize.Get("Retry"); ok {
+		return stack.Finalize.Insert(mw, "Retry", middleware.Before)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	name string
+	err  error
+}
+
+func (s testSweepable) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
+	return s.err
+}
+
+func (s testSweepable) FilterAttributes(ctx context.Context, f *filter.Filter) (filter.Attributes, error) {
+	return filter.Attributes{Name: s.name}, nil
+}
+
+func testReport(t *testing.T) *Report {
+	t.Helper()
+
+	r := NewReport()
+	start := time.Now()
+
+	ctx := reportContext(t.Context(), r, "us-west-2", "aws_vpc")
+	for _, sweepable := range []testSweepable{{name: "vpc-1"}, {name: "vpc-2", err: errors.New("DependencyViolation")}} {
+		ctx, rc := reportResource(ctx, sweepable)
+		if rc == nil {
+			t.Fatal("expected resource to be reported")
+		}
+		rc.done(start, false, sweepable.Delete(ctx))
+	}
+	r.sweeperDone("us-west-2", "aws_vpc", "ec2", start, errors.New("sweeping failed"))
+
+	ctx = reportContext(t.Context(), r, "us-west-2", "aws_glacier_vault")
+	ReportSkipped(ctx, errors.New("UnsupportedOperation"))
+	r.sweeperDone("us-west-2", "aws_glacier_vault", "glacier", start, nil)
+
+	r.sweeperDone("us-east-1", "aws_vpc", "ec2", start, nil)
+	r.finish()
+
+	return r
+}
+
+func TestReportWriteJSON(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	if err := testReport(t).WriteJSON(&sb); err != nil {
+		t.Fatalf("WriteJSON: %s", err)
+	}
+
+	var got Report
+	if err := json.Unmarshal([]byte(sb.String()), &got); err != nil {
+		t.Fatalf("unmarshaling report: %s", err)
+	}
+
+	if got, want := len(got.Regions), 2; got != want {
+		t.Fatalf("regions = %d, want %d", got, want)
+	}
+
+	sweepers := got.Regions[0].Sweepers
+	if got, want := len(sweepers), 2; got != want {
+		t.Fatalf("us-west-2 sweepers = %d, want %d", got, want)
+	}
+
+	vpc := sweepers[0]
+	if got, want := vpc.Status, ReportStatusFailed; got != want {
+		t.Errorf("aws_vpc status = %q, want %q", got, want)
+	}
+	if got, want := len(vpc.Resources), 2; got != want {
+		t.Fatalf("aws_vpc resources = %d, want %d", got, want)
+	}
+	if got, want := vpc.Resources[0].Status, ReportStatusDeleted; got != want {
+		t.Errorf("vpc-1 status = %q, want %q", got, want)
+	}
+	if got, want := vpc.Resources[1].Status, ReportStatusFailed; got != want {
+		t.Errorf("vpc-2 status = %q, want %q", got, want)
+	}
+	if got, want := vpc.Resources[1].Name, "vpc-2"; got != want {
+		t.Errorf("resource name = %q, want %q", got, want)
+	}
+
+	vault := sweepers[1]
+	if got, want := vault.Status, ReportStatusSkipped; got != want {
+		t.Errorf("aws_glacier_vault status = %q, want %q", got, want)
+	}
+	if got, want := vault.SkipReason, "UnsupportedOperation"; got != want {
+		t.Errorf("aws_glacier_vault skip reason = %q, want %q", got, want)
+	}
+}
+
+func TestReportWriteJUnit(t *testing.T) {
+	t.Parallel()
+
+	var sb strings.Builder
+	if err := testReport(t).WriteJUnit(&sb); err != nil {
+		t.Fatalf("WriteJUnit: %s", err)
+	}
+
+	got := sb.String()
+	for _, want := range []string{
+		`<testsuites name="sweepers" tests="3" failures="1" skipped="1"`,
+		`<testsuite name="us-west-2" tests="2" failures="1" skipped="1"`,
+		`<failure message="sweeping failed"></failure>`,
+		`<skipped message="UnsupportedOperation"></skipped>`,
+		`<system-out>resources: 2, deleted: 1, failed: 1, filtered: 0, retries: 0</system-out>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("JUnit report does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestReportingSweeperFunc(t *testing.T) { //nolint:paralleltest // Sets the current report.
+	r := NewReport()
+	currentReport.Store(r)
+	t.Cleanup(func() {
+		currentReport.Store(nil)
+	})
+
+	err := reportingSweeperFunc("aws_glacier_vault", func(ctx context.Context, region string) error {
+		// Resources may be reported from other goroutines.
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+
+			if _, rc := reportResource(ctx, testSweepable{name: "vault-1"}); rc == nil {
+				t.Error("expected resource to be reported")
+			}
+		}()
+		<-done
+
+		ReportSkipped(ctx, errors.New("UnsupportedOperation"))
+
+		return nil
+	})("us-west-2")
+	if err != nil {
+		t.Fatalf("sweeper: %s", err)
+	}
+
+	vault := r.sweeper("us-west-2", "aws_glacier_vault")
+	if got, want := vault.Status, ReportStatusSkipped; got != want {
+		t.Errorf("status = %q, want %q", got, want)
+	}
+	if got, want := vault.SkipReason, "UnsupportedOperation"; got != want {
+		t.Errorf("skip reason = %q, want %q", got, want)
+	}
+	if got, want := len(vault.Resources), 1; got != want {
+		t.Errorf("resources = %d, want %d", got, want)
+	}
+}
+
+func TestReportContextNoReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := reportContext(t.Context(), nil, "us-west-2", "aws_vpc")
+
+	if _, rc := reportResource(ctx, testSweepable{name: "vpc-1"}); rc != nil {
+		t.Error("expected no resource report")
+	}
+
+	// No-ops.
+	ReportSkipped(ctx, errors.New("skipped"))
+	var r *Report
+	r.sweeperDone("us-west-2", "aws_vpc", "ec2", time.Now(), nil)
+}
@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/depgraph"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// SchedulerOptions configures a run of the dependency-aware sweeper scheduler.
+type SchedulerOptions struct {
+	// Regions are the Regions to sweep, in order.
+	Regions []string
+
+	// Run is a comma-separated list of sweeper names, or parts of names, to run along with their dependencies.
+	// When empty, all sweepers are run.
+	Run string
+
+	// AllowFailures continues sweeping after a sweeper fails.
+	AllowFailures bool
+
+	// DryRun writes the plan to Output without running any sweepers.
+	DryRun bool
+
+	// Parallelism is the maximum number of sweepers run concurrently. Zero means unlimited.
+	Parallelism int
+
+	// ServiceParallelism is the maximum number of sweepers for the same service package run concurrently. Zero means unlimited.
+	ServiceParallelism int
+
+	// Output is where the plan is written in dry-run mode.
+	Output io.Writer
+
+	// Report, if set, records the run.
+	Report *Report
+}
+
+// plan is the order in which sweepers are run.
+// The sweepers in each level run concurrently once all previous levels have completed.
+type plan struct {
+	levels   [][]string
+	sweepers map[string]registeredSweeper
+}
+
+// newPlan returns the plan for running the selected sweepers, and their dependencies, in dependency order.
+func newPlan(ctx context.Context, sweepers map[string]registeredSweeper, run string) (*plan, error) {
+	g := depgraph.New()
+
+	for name := range sweepers {
+		g.AddNode(name)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sweepers)) {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				tflog.Warn(ctx, "Sweeper has dependency, but that sweeper was not found", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+				continue
+			}
+
+			// The dependency must be swept first.
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if filters := strings.Split(strings.ToLower(run), ","); run != "" {
+		selected := make(map[string]struct{})
+		for name := range sweepers {
+			if !slices.ContainsFunc(filters, func(filter string) bool {
+				return strings.Contains(strings.ToLower(name), strings.TrimSpace(filter))
+			}) {
+				continue
+			}
+
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			selected[name] = struct{}{}
+			for _, dependency := range dependencies {
+				selected[dependency] = struct{}{}
+			}
+		}
+
+		for name := range sweepers {
+			if _, ok := selected[name]; !ok {
+				g.RemoveNode(name)
+			}
+		}
+	}
+
+	levels, err := g.Levels()
+	if err != nil {
+		return nil, err
+	}
+
+	// The overall order of independent sweepers is not stable, so sort each level for a reproducible plan.
+	for _, level := range levels {
+		slices.Sort(level)
+	}
+
+	return &plan{
+		levels:   levels,
+		sweepers: sweepers,
+	}, nil
+}
+
+// Len returns the number of sweepers in the plan.
+func (p *plan) Len() int {
+	n := 0
+	for _, level := range p.levels {
+		n += len(level)
+	}
+
+	return n
+}
+
+// write writes a human-readable representation of the plan.
+func (p *plan) write(w io.Writer, regions []string) error {
+	if _, err := fmt.Fprintf(w, "Sweeper plan: %d sweepers in %d levels for Region(s) %s\n", p.Len(), len(p.levels), strings.Join(regions, ", ")); err != nil {
+		return err
+	}
+
+	for i, level := range p.levels {
+		if _, err := fmt.Fprintf(w, "\nLevel %d (%d sweepers, run concurrently):\n", i+1, len(level)); err != nil {
+			return err
+		}
+
+		for _, name := range level {
+			s := p.sweepers[name]
+
+			var dependencies string
+			if len(s.Dependencies) > 0 {
+				dependencies = fmt.Sprintf(", after %s", strings.Join(s.Dependencies, ", "))
+			}
+			if _, err := fmt.Fprintf(w, "  - %s (%s%s)\n", name, s.servicePackageName, dependencies); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Schedule runs the registered sweepers in dependency order, running independent sweepers concurrently.
+func Schedule(ctx context.Context, opts SchedulerOptions) error {
+	registeredSweepersLock.Lock()
+	sweepers := maps.Clone(registeredSweepers)
+	registeredSweepersLock.Unlock()
+
+	p, err := newPlan(ctx, sweepers, opts.Run)
+	if err != nil {
+		return fmt.Errorf("planning sweepers: %w", err)
+	}
+
+	if opts.DryRun {
+		return p.write(opts.Output, opts.Regions)
+	}
+
+	if opts.Report != nil {
+		currentReport.Store(opts.Report)
+		defer func() {
+			opts.Report.finish()
+			currentReport.Store(nil)
+		}()
+	}
+
+	var errs []error
+	for _, region := range opts.Regions {
+		region = strings.TrimSpace(region)
+
+		if err := p.run(Context(region), region, opts); err != nil {
+			errs = append(errs, err)
+
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// run runs the plan's sweepers for the specified Region.
+func (p *plan) run(ctx context.Context, region string, opts SchedulerOptions) error {
+	limiter := ratelimit.NewLimiter(0, opts.Parallelism)
+	serviceLimiters := make(map[string]*ratelimit.Limiter)
+	for _, s := range p.sweepers {
+		if _, ok := serviceLimiters[s.servicePackageName]; !ok {
+			serviceLimiters[s.servicePackageName] = ratelimit.NewLimiter(0, opts.ServiceParallelism)
+		}
+	}
+
+	start := time.Now()
+	tflog.Info(ctx, "Running sweepers", map[string]any{
+		"sweepers": p.Len(),
+		"levels":   len(p.levels),
+	})
+
+	var errs []error
+	for i, level := range p.levels {
+		var g tfsync.Group
+
+		for _, name := range level {
+			s := p.sweepers[name]
+
+			g.Go(ctx, func(ctx context.Context) error {
+				// Acquire service capacity first so that sweepers waiting on a busy service don't hold overall capacity.
+				for _, l := range []*ratelimit.Limiter{serviceLimiters[s.servicePackageName], limiter} {
+					release, err := l.Acquire(ctx)
+					if err != nil {
+						return err
+					}
+					defer release()
+				}
+
+				start := time.Now()
+				err := s.F(region)
+				tflog.Info(ctx, "Completed sweeper", map[string]any{
+					"sweeper": name,
+					"elapsed": time.Since(start).String(),
+				})
+				opts.Report.sweeperDone(region, name, s.servicePackageName, start, err)
+
+				if err != nil {
+					return fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+				}
+
+				return nil
+			})
+		}
+
+		if err := g.Wait(ctx); err != nil {
+			tflog.Error(ctx, "Sweeper level failed", map[string]any{
+				"level": i + 1,
+				"error": err.Error(),
+			})
+			errs = append(errs, err)
+
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Completed sweepers", map[string]any{
+		"elapsed": time.Since(start).String(),
+	})
+
+	return errors.Join(errs...)
+}
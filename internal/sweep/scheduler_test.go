@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testSweepers(f func(string) error) map[string]registeredSweeper {
+	newSweeper := func(name, servicePackageName string, dependencies ...string) registeredSweeper {
+		return registeredSweeper{
+			Sweeper: &resource.Sweeper{
+				Name:         name,
+				F:            func(region string) error { return f(name) },
+				Dependencies: dependencies,
+			},
+			servicePackageName: servicePackageName,
+		}
+	}
+
+	return map[string]registeredSweeper{
+		"aws_vpc":              newSweeper("aws_vpc", "ec2", "aws_subnet", "aws_internet_gateway"),
+		"aws_subnet":           newSweeper("aws_subnet", "ec2", "aws_instance"),
+		"aws_internet_gateway": newSweeper("aws_internet_gateway", "ec2"),
+		"aws_instance":         newSweeper("aws_instance", "ec2"),
+		"aws_s3_bucket":        newSweeper("aws_s3_bucket", "s3", "aws_not_registered"),
+	}
+}
+
+func TestNewPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		run  string
+		want [][]string
+	}{
+		{
+			name: "all",
+			want: [][]string{
+				{"aws_instance", "aws_internet_gateway", "aws_s3_bucket"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			name: "run with dependencies",
+			run:  "AWS_SUBNET",
+			want: [][]string{
+				{"aws_instance"},
+				{"aws_subnet"},
+			},
+		},
+		{
+			name: "run multiple",
+			run:  "s3, gateway",
+			want: [][]string{
+				{"aws_internet_gateway", "aws_s3_bucket"},
+			},
+		},
+		{
+			name: "run no match",
+			run:  "aws_lambda",
+			want: [][]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := newPlan(t.Context(), testSweepers(nil), testCase.run)
+			if err != nil {
+				t.Fatalf("newPlan: %s", err)
+			}
+
+			if !slices.EqualFunc(p.levels, testCase.want, slices.Equal) {
+				t.Errorf("levels = %v, want %v", p.levels, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPlanWrite(t *testing.T) {
+	t.Parallel()
+
+	p, err := newPlan(t.Context(), testSweepers(nil), "aws_subnet")
+	if err != nil {
+		t.Fatalf("newPlan: %s", err)
+	}
+
+	var sb strings.Builder
+	if err := p.write(&sb, []string{"us-west-2"}); err != nil {
+		t.Fatalf("write: %s", err)
+	}
+
+	want := `Sweeper plan: 2 sweepers in 2 levels for Region(s) us-west-2
+
+Level 1 (1 sweepers, run concurrently):
+  - aws_instance (ec2)
+
+Level 2 (1 sweepers, run concurrently):
+  - aws_subnet (ec2, after aws_instance)
+`
+	if got := sb.String(); got != want {
+		t.Errorf("write() = %q, want %q", got, want)
+	}
+}
+
+func TestPlanRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		failing       string
+		allowFailures bool
+		wantErr       bool
+		wantNotRun    []string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:       "failure stops later levels",
+			failing:    "aws_instance",
+			wantErr:    true,
+			wantNotRun: []string{"aws_subnet", "aws_vpc"},
+		},
+		{
+			name:          "allow failures",
+			failing:       "aws_instance",
+			allowFailures: true,
+			wantErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var lock sync.Mutex
+			var order []string
+			sweepers := testSweepers(func(name string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				order = append(order, name)
+				if name == testCase.failing {
+					return errors.New("failed")
+				}
+
+				return nil
+			})
+
+			p, err := newPlan(context.Background(), sweepers, "")
+			if err != nil {
+				t.Fatalf("newPlan: %s", err)
+			}
+
+			err = p.run(t.Context(), "us-west-2", SchedulerOptions{
+				AllowFailures:      testCase.allowFailures,
+				ServiceParallelism: 1,
+			})
+			if got := err != nil; got != testCase.wantErr {
+				t.Fatalf("run() error = %v, want error %t", err, testCase.wantErr)
+			}
+
+			for _, name := range testCase.wantNotRun {
+				if slices.Contains(order, name) {
+					t.Errorf("sweeper %s ran, want not run", name)
+				}
+			}
+
+			// Each sweeper must run after its dependencies.
+			for i, name := range order {
+				for _, dependency := range sweepers[name].Dependencies {
+					if _, ok := sweepers[dependency]; ok && !slices.Contains(order[:i], dependency) {
+						t.Errorf("sweeper %s ran before its dependency %s", name, dependency)
+					}
+				}
+			}
+		})
+	}
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -39,6 +42,79 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// FilterAttributes returns the attributes used to filter the sweep.
+// If the filter needs tags or creation time and they have not been set by the sweeper, the resource is read.
+// Tags of resources that use transparent tagging are listed if the resource's Read function does not set them.
+func (sr *sweepResource) FilterAttributes(ctx context.Context, f *filter.Filter) (filter.Attributes, error) {
+	attrs := filterAttributes(sr.resource, sr.d)
+
+	if (f.NeedsTags() && attrs.Tags == nil) || (f.NeedsCreatedAt() && attrs.CreatedAt.IsZero()) {
+		ctx = tflog.SetField(ctx, "id", sr.d.Id())
+		ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+		if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+			return filter.Attributes{}, err
+		}
+
+		// A resource that no longer exists has no attributes to match.
+		if sr.d.Id() == "" {
+			return filter.Attributes{}, nil
+		}
+
+		attrs = filterAttributes(sr.resource, sr.d)
+
+		if f.NeedsTags() && attrs.Tags == nil {
+			tags, err := resourceTags(ctx, sr.resource, sr.d, sr.meta)
+			if err != nil {
+				return filter.Attributes{}, err
+			}
+
+			attrs.Tags = tags
+		}
+	}
+
+	return attrs, nil
+}
+
+func filterAttributes(resource *schema.Resource, d *schema.ResourceData) filter.Attributes {
+	attrs := filter.Attributes{
+		Name: d.Id(),
+	}
+
+	if _, ok := resource.SchemaMap()[names.AttrName]; ok {
+		if v, ok := d.Get(names.AttrName).(string); ok && v != "" {
+			attrs.Name = v
+		}
+	}
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := resource.SchemaMap()[k]; !ok {
+			continue
+		}
+
+		if v, ok := d.Get(k).(map[string]any); ok && len(v) > 0 {
+			attrs.Tags = make(map[string]string, len(v))
+			for k, v := range v {
+				attrs.Tags[k], _ = v.(string)
+			}
+			break
+		}
+	}
+
+	for _, k := range filter.CreatedAtAttributeNames {
+		if _, ok := resource.SchemaMap()[k]; !ok {
+			continue
+		}
+
+		if t, ok := filter.ParseTime(d.Get(k)); ok {
+			attrs.CreatedAt = t
+			break
+		}
+	}
+
+	return attrs
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockServicePackage struct{}
+
+var _ tftags.ServiceTagLister = &mockServicePackage{}
+
+func (sp *mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
+	return []*inttypes.ServicePackageFrameworkDataSource{}
+}
+
+func (sp *mockServicePackage) FrameworkResources(context.Context) []*inttypes.ServicePackageFrameworkResource {
+	return []*inttypes.ServicePackageFrameworkResource{}
+}
+
+func (sp *mockServicePackage) SDKDataSources(context.Context) []*inttypes.ServicePackageSDKDataSource {
+	return []*inttypes.ServicePackageSDKDataSource{}
+}
+
+func (sp *mockServicePackage) SDKResources(context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceTagged,
+			TypeName: "aws_test_tagged",
+			Name:     "Tagged",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+		},
+	}
+}
+
+func (sp *mockServicePackage) ServicePackageName() string {
+	return "test"
+}
+
+func (sp *mockServicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	tags := map[string]string{
+		"aws:cloudformation:stack-name": "example",
+	}
+	if identifier == "arn:aws:test:us-west-2:123456789012:tagged/tf-acc-test-1" {
+		tags["CreatedBy"] = "acctest"
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, tags))
+	}
+
+	return nil
+}
+
+func resourceTagged() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: resourceTaggedRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTagsAll: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTaggedRead(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	// Tags are set by transparent tagging.
+	d.Set(names.AttrARN, "arn:aws:test:us-west-2:123456789012:tagged/"+d.Id())
+	d.Set(names.AttrName, d.Id())
+
+	return nil
+}
+
+func TestSweepResourceFilterAttributesTags(t *testing.T) {
+	t.Parallel()
+
+	sp := &mockServicePackage{}
+	meta := &conns.AWSClient{}
+	meta.SetServicePackages(t.Context(), map[string]conns.ServicePackage{
+		sp.ServicePackageName(): sp,
+	})
+
+	f := &filter.Filter{
+		Tags: map[string]string{
+			"CreatedBy": "acctest",
+		},
+	}
+
+	testCases := map[string]struct {
+		id          string
+		expectMatch bool
+	}{
+		"tagged": {
+			id:          "tf-acc-test-1",
+			expectMatch: true,
+		},
+		"untagged": {
+			id: "tf-acc-test-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			r := resourceTagged()
+			d := r.Data(nil)
+			d.SetId(testCase.id)
+
+			attrs, err := NewSweepResource(r, d, meta).FilterAttributes(ctx, f)
+			if err != nil {
+				t.Fatalf("FilterAttributes: %s", err)
+			}
+
+			if attrs.Tags == nil {
+				t.Fatal("expected tags to be known")
+			}
+			if _, ok := attrs.Tags["aws:cloudformation:stack-name"]; ok {
+				t.Errorf("expected system tags to be ignored, got %v", attrs.Tags)
+			}
+
+			if got, want := f.Match(attrs, time.Now()), testCase.expectMatch; got != want {
+				t.Errorf("Match(%v) = %t, want %t", attrs, got, want)
+			}
+		})
+	}
+}
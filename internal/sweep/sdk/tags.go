@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Sweepers read resources without the provider's interceptors, so transparent tagging does not set a resource's tags.
+
+// taggedResource is a resource type that uses transparent tagging.
+type taggedResource struct {
+	servicePackage conns.ServicePackage
+	tags           interceptors.HTags
+}
+
+var (
+	taggedResourcesLock sync.Mutex
+	// taggedResources maps a resource type's Read function to its transparent tagging configuration.
+	taggedResources map[uintptr]taggedResource
+)
+
+// readFunc returns the address of the resource's Read function, or 0 if it has none.
+func readFunc(resource *schema.Resource) uintptr {
+	for _, f := range []any{resource.ReadWithoutTimeout, resource.ReadContext, resource.Read} {
+		if v := reflect.ValueOf(f); !v.IsNil() {
+			return v.Pointer()
+		}
+	}
+
+	return 0
+}
+
+// findTaggedResource returns the transparent tagging configuration of the specified resource type.
+// Resource types are identified by their Read function as sweepers don't have access to the type name.
+func findTaggedResource(ctx context.Context, resource *schema.Resource, meta *conns.AWSClient) (taggedResource, bool) {
+	taggedResourcesLock.Lock()
+	defer taggedResourcesLock.Unlock()
+
+	if taggedResources == nil {
+		taggedResources = make(map[uintptr]taggedResource)
+
+		for sp := range meta.ServicePackages(ctx) {
+			for _, v := range sp.SDKResources(ctx) {
+				h := interceptors.HTags(v.Tags)
+				if !h.Enabled() {
+					continue
+				}
+
+				if k := readFunc(v.Factory()); k != 0 {
+					taggedResources[k] = taggedResource{
+						servicePackage: sp,
+						tags:           h,
+					}
+				}
+			}
+		}
+	}
+
+	v, ok := taggedResources[readFunc(resource)]
+
+	return v, ok
+}
+
+// resourceTags returns the tags of a resource read with the specified context.
+// If the resource's Read function did not set tags, they are listed using the service package's tagging API.
+// A nil value is returned if the resource's tags cannot be determined.
+func resourceTags(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta *conns.AWSClient) (map[string]string, error) {
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	v, ok := findTaggedResource(ctx, resource, meta)
+	if !ok {
+		return nil, nil
+	}
+
+	if tagsInContext.TagsOut.IsNone() {
+		identifier := v.tags.GetIdentifierSDKv2(ctx, d)
+		if identifier == "" {
+			return nil, nil
+		}
+
+		if err := v.tags.ListTags(ctx, v.servicePackage, meta, identifier); err != nil {
+			return nil, err
+		}
+	}
+
+	// The service package has no tagging API.
+	if tagsInContext.TagsOut.IsNone() {
+		return nil, nil
+	}
+
+	return tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(v.servicePackage.ServicePackageName()).Map(), nil
+}
@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/filter"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -29,15 +33,35 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// The lock is held while a client is initialized, as sweepers may be run concurrently by the sweeper scheduler.
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
+
+// sweepFilter returns the filter configured via environment variables for all sweepers.
+var sweepFilter = sync.OnceValues(filter.FromEnv)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
 
-	_, _, err := envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
+	f, err := sweepFilter()
+	if err != nil {
+		return nil, err
+	}
+	if !f.IsEmpty() {
+		tflog.Info(ctx, "Filtering swept resources", map[string]any{
+			"filter": f.String(),
+		})
+	}
+
+	_, _, err = envvar.RequireOneOf([]string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +82,7 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.SetServicePackages(ctx, servicePackageMap)
 
 	conf := &conns.Config{
+		APIOptions:       []func(*middleware.Stack) error{filterGuardAPIOption, reportRetriesAPIOption},
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
@@ -104,20 +129,73 @@ type Sweepable interface {
 	Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error
 }
 
+// Filterable is implemented by Sweepables that can describe the resource they delete, so that sweeps can be filtered.
+type Filterable interface {
+	Sweepable
+	FilterAttributes(ctx context.Context, f *filter.Filter) (filter.Attributes, error)
+}
+
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	f, err := sweepFilter()
+	if err != nil {
+		return err
+	}
+
 	var g tfsync.Group
 
 	for _, sweepable := range sweepables {
 		g.Go(ctx, func(ctx context.Context) error {
-			return sweepable.Delete(ctx, optFns...)
+			ctx, rc := reportResource(ctx, sweepable)
+			start := time.Now()
+
+			ok, err := filterSweepable(ctx, f, sweepable)
+			if err == nil && ok {
+				err = sweepable.Delete(withFilterMatched(ctx), optFns...)
+			}
+			rc.done(start, !ok, err)
+
+			return err
 		})
 	}
 
 	return g.Wait(ctx)
 }
 
+// filterSweepable returns whether the Sweepable matches the filter.
+// When filtering, Sweepables that cannot describe the resource they delete are not swept.
+func filterSweepable(ctx context.Context, f *filter.Filter, sweepable Sweepable) (bool, error) {
+	if f.IsEmpty() {
+		return true, nil
+	}
+
+	v, ok := sweepable.(Filterable)
+	if !ok {
+		tflog.Warn(ctx, "Skipping resource, sweeper does not support filtering")
+		return false, nil
+	}
+
+	attrs, err := v.FilterAttributes(ctx, f)
+	if retry.NotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !f.Match(attrs, time.Now()) {
+		tflog.Debug(ctx, "Skipping resource, does not match filter", map[string]any{
+			"name":       attrs.Name,
+			"tags":       attrs.Tags,
+			"created_at": attrs.CreatedAt,
+		})
+		return false, nil
+	}
+
+	return true, nil
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
@@ -5,12 +5,26 @@ package sweep_test
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepScheduler          = flag.Bool("sweep-scheduler", false, "Enable to run Sweepers with the dependency-aware sweeper scheduler")
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "Enable to print the sweeper scheduler's plan without running any Sweepers")
+	flagSweepParallelism        = flag.Int("sweep-parallelism", 0, "Maximum number of Sweepers run concurrently by the sweeper scheduler (0 is unlimited)")
+	flagSweepServiceParallelism = flag.Int("sweep-service-parallelism", 1, "Maximum number of Sweepers for the same service run concurrently by the sweeper scheduler (0 is unlimited)")
+	flagSweepReportJSON         = flag.String("sweep-report-json", "", "File to write a JSON report of the Sweeper run to")
+	flagSweepReportJUnit        = flag.String("sweep-report-junit", "", "File to write a JUnit XML report of the Sweeper run to")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +32,67 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	flag.Parse()
+
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the acceptance testing framework.
+	report := *flagSweepReportJSON != "" || *flagSweepReportJUnit != ""
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" && (*flagSweepScheduler || *flagSweepDryRun || report) {
+		opts := sweep.SchedulerOptions{
+			Regions:            strings.Split(regions, ","),
+			Run:                flag.Lookup("sweep-run").Value.String(),
+			AllowFailures:      flag.Lookup("sweep-allow-failures").Value.String() == "true",
+			DryRun:             *flagSweepDryRun,
+			Parallelism:        *flagSweepParallelism,
+			ServiceParallelism: *flagSweepServiceParallelism,
+			Output:             os.Stdout,
+		}
+
+		// Reports are recorded by the sweeper scheduler.
+		// Unless the scheduler was requested, run Sweepers one at a time as the acceptance testing framework does.
+		if report {
+			opts.Report = sweep.NewReport()
+
+			if !*flagSweepScheduler {
+				opts.Parallelism = 1
+			}
+		}
+
+		err := sweep.Schedule(ctx, opts)
+
+		if opts.Report != nil {
+			if err := writeReport(*flagSweepReportJSON, opts.Report.WriteJSON); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing JSON report: %s\n", err)
+			}
+			if err := writeReport(*flagSweepReportJUnit, opts.Report.WriteJUnit); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing JUnit report: %s\n", err)
+			}
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running sweepers: %s\n", err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+func writeReport(path string, write func(io.Writer) error) error {
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -53,17 +53,29 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying the path to a local organizational tag policy JSON document
+	// used in place of retrieving required tags from the Resource Groups Tagging API
+	TagPolicyFileEnvVar = "TF_AWS_TAG_POLICY_FILE"
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules contains tags to default across a subset of resources.
+	// Use ForResource to resolve the configuration for a resource type.
+	Rules []DefaultTagsRule
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// Rules contains options for removing resource tags from a subset of resources.
+	// Use ForResource to resolve the configuration for a resource type.
+	Rules []IgnoreTagsRule
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -78,6 +90,10 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// EnforcedTagRules is a mapping of Terraform resource type names to the tag
+	// key capitalization and allowed value rules enforced by the effective tag policy
+	EnforcedTagRules map[string][]TagPolicyRule
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
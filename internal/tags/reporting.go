@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// ReportingConfig contains options for reporting how resource tags are computed and applied.
+type ReportingConfig struct {
+	// Provenance reports the source of every key in tags_all during plan.
+	Provenance bool
+
+	// VerifyOnCreate re-reads tags after resource creation and reports any that were not applied.
+	VerifyOnCreate bool
+}
+
+// TagSource identifies the configuration layer a resource tag originates from.
+type TagSource string
+
+const (
+	// TagSourceDefaultTags is a tag inherited from the provider's default_tags.
+	TagSourceDefaultTags TagSource = "default_tags"
+	// TagSourceResource is a tag configured on the resource.
+	TagSourceResource TagSource = "resource"
+	// TagSourceResourceOverride is a tag configured on the resource that overrides a default_tags value.
+	TagSourceResourceOverride TagSource = "resource (overrides default_tags)"
+	// TagSourceIgnoreTags is a configured tag that is removed by the provider's ignore_tags.
+	TagSourceIgnoreTags TagSource = "ignore_tags"
+)
+
+// TagProvenance maps tag keys to their source.
+type TagProvenance map[string]TagSource
+
+// Provenance returns the source of every key resulting from merging the provider's
+// default_tags with a resource's configured tags.
+// Keys that are subsequently removed by ignore_tags, and so are absent from tags_all, are reported as TagSourceIgnoreTags.
+func Provenance(defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resourceTags KeyValueTags) TagProvenance {
+	defaultTags := defaultConfig.GetTags()
+	allTags := defaultConfig.MergeTags(resourceTags)
+	keptTags := allTags.IgnoreConfig(ignoreConfig)
+
+	provenance := make(TagProvenance, len(allTags))
+	for k := range allTags {
+		switch {
+		case !keptTags.KeyExists(k):
+			provenance[k] = TagSourceIgnoreTags
+		case resourceTags.KeyExists(k) && defaultTags.KeyExists(k):
+			provenance[k] = TagSourceResourceOverride
+		case resourceTags.KeyExists(k):
+			provenance[k] = TagSourceResource
+		default:
+			provenance[k] = TagSourceDefaultTags
+		}
+	}
+
+	return provenance
+}
+
+// String returns the provenance as a comma-separated list of key and source pairs, sorted by key.
+func (p TagProvenance) String() string {
+	pairs := make([]string, 0, len(p))
+	for _, k := range slices.Sorted(maps.Keys(p)) {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", k, p[k]))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+// NotApplied returns the tags that are absent from, or have a different value in, applied.
+func (tags KeyValueTags) NotApplied(applied KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		// Null and empty values are equivalent once applied.
+		if !applied.KeyExists(k) || aws.ToString(tags.KeyValue(k)) != aws.ToString(applied.KeyValue(k)) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
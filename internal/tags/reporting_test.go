@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestProvenance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		resourceTags  map[string]string
+		want          string
+	}{
+		{
+			name: "no tags",
+			want: "",
+		},
+		{
+			name:         "resource only",
+			resourceTags: map[string]string{"Name": "example"},
+			want:         "Name: resource",
+		},
+		{
+			name: "default and resource",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "team", "Environment": "test"}),
+			},
+			resourceTags: map[string]string{"Name": "example", "Environment": "prod"},
+			want:         "Environment: resource (overrides default_tags), Name: resource, Owner: default_tags",
+		},
+		{
+			name: "ignored",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"Owner": "team"}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"Owner"}),
+				KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+			},
+			resourceTags: map[string]string{"Name": "example", "kubernetes.io/cluster": "owned"},
+			want:         "Name: resource, Owner: ignore_tags, kubernetes.io/cluster: ignore_tags",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Provenance(testCase.defaultConfig, testCase.ignoreConfig, New(ctx, testCase.resourceTags)).String()
+
+			if got != testCase.want {
+				t.Errorf("Provenance() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsNotApplied(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sent := New(ctx, map[string]any{
+		"kept":    "value",
+		"dropped": "value",
+		"changed": "value",
+		"empty":   nil,
+	})
+	applied := New(ctx, map[string]string{
+		"kept":    "value",
+		"changed": "VALUE",
+		"empty":   "",
+		"extra":   "value",
+	})
+
+	got := sent.NotApplied(applied).Keys()
+	slices.Sort(got)
+
+	if want := []string{"changed", "dropped"}; !slices.Equal(got, want) {
+		t.Errorf("NotApplied() = %v, want %v", got, want)
+	}
+}
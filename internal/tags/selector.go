@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"slices"
+
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// ResourceSelector limits a default_tags or ignore_tags rule to a subset of resources.
+// An empty selector matches every resource.
+type ResourceSelector struct {
+	// IncludeResourceTypes are resource type name patterns (e.g. "aws_ec2_*") to match.
+	// When empty, all resource types are matched.
+	IncludeResourceTypes []string
+
+	// ExcludeResourceTypes are resource type name patterns to not match.
+	// Exclusions take precedence over inclusions.
+	ExcludeResourceTypes []string
+
+	// Services are service package names (e.g. "ec2") to match.
+	// When empty, all service packages are matched.
+	Services []string
+}
+
+// Matches returns whether the selector matches the specified resource type and service package.
+// Patterns may contain '*' and '?' wildcards.
+func (s ResourceSelector) Matches(typeName, servicePackageName string) bool {
+	if len(s.Services) > 0 && !slices.Contains(s.Services, servicePackageName) {
+		return false
+	}
+
+	match := func(pattern string) bool {
+		return inttypes.WildcardMatch(pattern, typeName)
+	}
+
+	if len(s.IncludeResourceTypes) > 0 && !slices.ContainsFunc(s.IncludeResourceTypes, match) {
+		return false
+	}
+
+	return !slices.ContainsFunc(s.ExcludeResourceTypes, match)
+}
+
+// DefaultTagsRule contains tags to default across the resources matched by a selector.
+type DefaultTagsRule struct {
+	ResourceSelector
+
+	Tags KeyValueTags
+}
+
+// IgnoreTagsRule contains options for removing tags from the resources matched by a selector.
+type IgnoreTagsRule struct {
+	ResourceSelector
+
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// ForResource returns the default tags that apply to the specified resource type and service package.
+// Tags from matching rules are merged, in order, over the tags defaulted across all resources.
+func (dc *DefaultConfig) ForResource(typeName, servicePackageName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, rule := range dc.Rules {
+		if rule.Matches(typeName, servicePackageName) {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// ForResource returns the tag removal options that apply to the specified resource type and service package.
+// Keys and key prefixes from matching rules are added to those removed from all resources.
+func (ic *IgnoreConfig) ForResource(typeName, servicePackageName string) *IgnoreConfig {
+	if ic == nil || len(ic.Rules) == 0 {
+		return ic
+	}
+
+	keys, keyPrefixes := ic.Keys, ic.KeyPrefixes
+	for _, rule := range ic.Rules {
+		if rule.Matches(typeName, servicePackageName) {
+			keys = keys.Merge(rule.Keys)
+			keyPrefixes = keyPrefixes.Merge(rule.KeyPrefixes)
+		}
+	}
+
+	if len(keys) == 0 && len(keyPrefixes) == 0 {
+		return nil
+	}
+
+	config := &IgnoreConfig{}
+	if len(keys) > 0 {
+		config.Keys = keys
+	}
+	if len(keyPrefixes) > 0 {
+		config.KeyPrefixes = keyPrefixes
+	}
+
+	return config
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestResourceSelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		selector           ResourceSelector
+		typeName           string
+		servicePackageName string
+		want               bool
+	}{
+		{
+			name:     "empty",
+			typeName: "aws_instance",
+			want:     true,
+		},
+		{
+			name:     "include match",
+			selector: ResourceSelector{IncludeResourceTypes: []string{"aws_s3_*", "aws_ec2_*"}},
+			typeName: "aws_ec2_host",
+			want:     true,
+		},
+		{
+			name:     "include no match",
+			selector: ResourceSelector{IncludeResourceTypes: []string{"aws_ec2_*"}},
+			typeName: "aws_instance",
+			want:     false,
+		},
+		{
+			name:     "exclude match",
+			selector: ResourceSelector{ExcludeResourceTypes: []string{"aws_instance"}},
+			typeName: "aws_instance",
+			want:     false,
+		},
+		{
+			name: "exclude takes precedence",
+			selector: ResourceSelector{
+				IncludeResourceTypes: []string{"aws_ec2_*"},
+				ExcludeResourceTypes: []string{"aws_ec2_?ost"},
+			},
+			typeName: "aws_ec2_host",
+			want:     false,
+		},
+		{
+			name:               "service match",
+			selector:           ResourceSelector{Services: []string{"ec2"}},
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want:               true,
+		},
+		{
+			name:               "service no match",
+			selector:           ResourceSelector{Services: []string{"ec2"}},
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			want:               false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.selector.Matches(testCase.typeName, testCase.servicePackageName); got != testCase.want {
+				t.Errorf("Matches(%q, %q) = %t, want %t", testCase.typeName, testCase.servicePackageName, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &DefaultConfig{
+		Tags: New(ctx, map[string]string{"Owner": "team", "CostCenter": "none"}),
+		Rules: []DefaultTagsRule{
+			{
+				ResourceSelector: ResourceSelector{IncludeResourceTypes: []string{"aws_instance", "aws_ebs_*"}},
+				Tags:             New(ctx, map[string]string{"CostCenter": "1234"}),
+			},
+			{
+				ResourceSelector: ResourceSelector{Services: []string{"s3"}},
+				Tags:             New(ctx, map[string]string{"Backup": "daily"}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		config             *DefaultConfig
+		typeName           string
+		servicePackageName string
+		want               map[string]string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:               "no matching rules",
+			config:             config,
+			typeName:           "aws_vpc",
+			servicePackageName: "ec2",
+			want:               map[string]string{"Owner": "team", "CostCenter": "none"},
+		},
+		{
+			name:               "matching rule overrides",
+			config:             config,
+			typeName:           "aws_ebs_volume",
+			servicePackageName: "ec2",
+			want:               map[string]string{"Owner": "team", "CostCenter": "1234"},
+		},
+		{
+			name:               "matching service",
+			config:             config,
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			want:               map[string]string{"Owner": "team", "CostCenter": "none", "Backup": "daily"},
+		},
+		{
+			name: "rules only, no match",
+			config: &DefaultConfig{
+				Rules: config.Rules,
+			},
+			typeName:           "aws_vpc",
+			servicePackageName: "ec2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.ForResource(testCase.typeName, testCase.servicePackageName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Fatalf("ForResource() = %v, want nil", got.Tags.Map())
+				}
+				return
+			}
+
+			if !got.Tags.Equal(New(ctx, testCase.want)) {
+				t.Errorf("ForResource() = %v, want %v", got.Tags.Map(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestIgnoreConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &IgnoreConfig{
+		Keys: New(ctx, []string{"LastScanned"}),
+		Rules: []IgnoreTagsRule{
+			{
+				ResourceSelector: ResourceSelector{ExcludeResourceTypes: []string{"aws_eks_*"}},
+				KeyPrefixes:      New(ctx, []string{"kubernetes.io/"}),
+			},
+		},
+	}
+
+	tags := New(ctx, map[string]string{"Name": "example", "LastScanned": "today", "kubernetes.io/cluster/example": "owned"})
+
+	testCases := []struct {
+		name     string
+		typeName string
+		want     []string
+	}{
+		{
+			name:     "matching rule",
+			typeName: "aws_instance",
+			want:     []string{"Name"},
+		},
+		{
+			name:     "excluded",
+			typeName: "aws_eks_cluster",
+			want:     []string{"Name", "kubernetes.io/cluster/example"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tags.IgnoreConfig(config.ForResource(testCase.typeName, "")).Keys()
+			slices.Sort(got)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("IgnoreConfig(ForResource(%q)) = %v, want keys %v", testCase.typeName, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TagPolicyRule is the rule for a single tag key in an organizational tag policy.
+type TagPolicyRule struct {
+	// Key is the tag key with the capitalization required by the policy.
+	Key string
+
+	// Values are the allowed tag values. A value ending in "*" allows any value
+	// with that prefix. When empty any value is allowed.
+	Values []string
+}
+
+// TagPolicyViolation describes a tag that does not comply with an organizational tag policy.
+type TagPolicyViolation struct {
+	// Key is the offending tag key as configured.
+	Key string
+
+	Detail string
+}
+
+// Violations returns the tags in tags that do not comply with the tag policy rules
+// enforced for the specified Terraform resource type, sorted by tag key.
+func (c *TagPolicyConfig) Violations(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if c == nil {
+		return nil
+	}
+
+	rules, ok := c.EnforcedTagRules[typeName]
+	if !ok {
+		return nil
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	var violations []TagPolicyViolation
+	for _, k := range keys {
+		idx := slices.IndexFunc(rules, func(rule TagPolicyRule) bool {
+			return strings.EqualFold(rule.Key, k)
+		})
+		if idx == -1 {
+			continue
+		}
+		rule := rules[idx]
+
+		if k != rule.Key {
+			violations = append(violations, TagPolicyViolation{
+				Key:    k,
+				Detail: fmt.Sprintf("An organizational tag policy requires tag key %q to be capitalized as %q for %s", k, rule.Key, typeName),
+			})
+		}
+
+		if v := tags.KeyValue(k); v != nil && !rule.allows(*v) {
+			detail := fmt.Sprintf("An organizational tag policy does not allow value %q for tag %q on %s; allowed values are %q", *v, k, typeName, rule.Values)
+			if i := slices.IndexFunc(rule.Values, func(allowed string) bool {
+				return strings.EqualFold(allowed, *v)
+			}); i != -1 {
+				detail = fmt.Sprintf("An organizational tag policy requires value %q for tag %q to be capitalized as %q for %s", *v, k, rule.Values[i], typeName)
+			}
+			violations = append(violations, TagPolicyViolation{
+				Key:    k,
+				Detail: detail,
+			})
+		}
+	}
+
+	return violations
+}
+
+func (r TagPolicyRule) allows(value string) bool {
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(r.Values, func(allowed string) bool {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return allowed == value
+	})
+}
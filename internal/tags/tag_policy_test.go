@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTagPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &TagPolicyConfig{
+		EnforcedTagRules: map[string][]TagPolicyRule{
+			"aws_test": {
+				{Key: "CostCenter", Values: []string{"100", "200*"}},
+				{Key: "Owner"},
+				{Key: "Team", Values: []string{"Engineering", "Finance"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		config   *TagPolicyConfig
+		typeName string
+		tags     map[string]string
+		want     []string
+	}{
+		{
+			name:     "nil config",
+			typeName: "aws_test",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		{
+			name:     "not enforced",
+			config:   config,
+			typeName: "aws_other",
+			tags:     map[string]string{"costcenter": "999"},
+		},
+		{
+			name:     "compliant",
+			config:   config,
+			typeName: "aws_test",
+			tags:     map[string]string{"CostCenter": "2001", "Owner": "anyone", "Other": "x"},
+		},
+		{
+			name:     "key case",
+			config:   config,
+			typeName: "aws_test",
+			tags:     map[string]string{"costcenter": "100", "OWNER": "me"},
+			want:     []string{`"OWNER" to be capitalized as "Owner"`, `"costcenter" to be capitalized as "CostCenter"`},
+		},
+		{
+			name:     "value not allowed",
+			config:   config,
+			typeName: "aws_test",
+			tags:     map[string]string{"CostCenter": "300"},
+			want:     []string{`does not allow value "300" for tag "CostCenter"`},
+		},
+		{
+			name:     "value case",
+			config:   config,
+			typeName: "aws_test",
+			tags:     map[string]string{"Team": "engineering"},
+			want:     []string{`value "engineering" for tag "Team" to be capitalized as "Engineering"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.config.Violations(testCase.typeName, New(ctx, testCase.tags))
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("Violations() = %v, want %d violations", got, len(testCase.want))
+			}
+			for i, want := range testCase.want {
+				if !strings.Contains(got[i].Detail, want) {
+					t.Errorf("Violations()[%d] = %q, want it to contain %q", i, got[i].Detail, want)
+				}
+			}
+			if !slices.IsSortedFunc(got, func(a, b TagPolicyViolation) int { return strings.Compare(a.Key, b.Key) }) {
+				t.Errorf("Violations() = %v, want sorted by key", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// allSupported is the resource type suffix matching every supported resource type of a service
+	allSupported = "ALL_SUPPORTED"
+)
+
+// Policy is the content of an Organizations tag policy, translated to Terraform resource types.
+type Policy struct {
+	// RequiredTags is a mapping of Terraform resource type names to the tags
+	// the policy reports as required
+	RequiredTags map[string]tftags.KeyValueTags
+
+	// EnforcedTagRules is a mapping of Terraform resource type names to the tag
+	// rules the policy enforces
+	EnforcedTagRules map[string][]tftags.TagPolicyRule
+}
+
+// ReadPolicyFile reads an Organizations tag policy JSON document from a local file.
+func ReadPolicyFile(ctx context.Context, filename string) (*Policy, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := parsePolicy(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing tag policy file (%s): %w", filename, err)
+	}
+
+	return policy, nil
+}
+
+// GetEffectivePolicy retrieves the effective tag policy for the calling account.
+func GetEffectivePolicy(ctx context.Context, awsConfig aws.Config) (*Policy, error) {
+	client := organizations.NewFromConfig(awsConfig)
+
+	output, err := client.DescribeEffectivePolicy(ctx, &organizations.DescribeEffectivePolicyInput{
+		PolicyType: awstypes.EffectivePolicyTypeTagPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return &Policy{}, nil
+	}
+
+	return parsePolicy(ctx, []byte(aws.ToString(output.EffectivePolicy.PolicyContent)))
+}
+
+// policyDocument is an Organizations tag policy, either as authored (using
+// inheritance operators such as "@@assign") or as an effective policy.
+type policyDocument struct {
+	Tags map[string]policyTag `json:"tags"`
+}
+
+type policyTag struct {
+	TagKey               policyValue `json:"tag_key"`
+	TagValue             policyValue `json:"tag_value"`
+	EnforcedFor          policyValue `json:"enforced_for"`
+	ReportRequiredTagFor policyValue `json:"report_required_tag_for"`
+}
+
+// policyValue is a tag policy element value after applying any value-setting operators.
+type policyValue []string
+
+func (v *policyValue) UnmarshalJSON(b []byte) error {
+	var values []string
+	if err := unmarshalStringOrList(b, &values); err == nil {
+		*v = values
+		return nil
+	}
+
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(b, &operators); err != nil {
+		return err
+	}
+
+	values = nil
+	for _, operator := range []string{"@@assign", "@@append", "@@remove"} {
+		raw, ok := operators[operator]
+		if !ok {
+			continue
+		}
+
+		var operands []string
+		if err := unmarshalStringOrList(raw, &operands); err != nil {
+			return fmt.Errorf("%s: %w", operator, err)
+		}
+
+		switch operator {
+		case "@@assign":
+			values = operands
+		case "@@append":
+			values = append(values, operands...)
+		case "@@remove":
+			values = slices.DeleteFunc(values, func(s string) bool {
+				return slices.Contains(operands, s)
+			})
+		}
+	}
+
+	*v = values
+	return nil
+}
+
+func unmarshalStringOrList(b []byte, values *[]string) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*values = []string{s}
+		return nil
+	}
+
+	return json.Unmarshal(b, values)
+}
+
+func parsePolicy(ctx context.Context, b []byte) (*Policy, error) {
+	var doc policyDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{
+		RequiredTags:     make(map[string]tftags.KeyValueTags),
+		EnforcedTagRules: make(map[string][]tftags.TagPolicyRule),
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(doc.Tags)) {
+		tag := doc.Tags[name]
+
+		key := name
+		if len(tag.TagKey) > 0 {
+			key = tag.TagKey[0]
+		}
+
+		for _, tfType := range terraformTypes(tag.ReportRequiredTagFor) {
+			newTags := tftags.New(ctx, []string{key})
+			if v, ok := policy.RequiredTags[tfType]; ok {
+				policy.RequiredTags[tfType] = v.Merge(newTags)
+			} else {
+				policy.RequiredTags[tfType] = newTags
+			}
+		}
+
+		rule := tftags.TagPolicyRule{
+			Key:    key,
+			Values: tag.TagValue,
+		}
+		for _, tfType := range terraformTypes(tag.EnforcedFor) {
+			policy.EnforcedTagRules[tfType] = append(policy.EnforcedTagRules[tfType], rule)
+		}
+	}
+
+	return policy, nil
+}
+
+// terraformTypes translates tag policy resource types (e.g. "ec2:instance" or
+// "ec2:ALL_SUPPORTED") into the corresponding Terraform resource type names.
+func terraformTypes(resourceTypes []string) []string {
+	var tfTypes []string
+
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, v...)
+				}
+			}
+			continue
+		}
+
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+
+	slices.Sort(tfTypes)
+
+	return slices.Compact(tfTypes)
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy, err := parsePolicy(ctx, []byte(`{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200"], "@@append": ["300*"], "@@remove": ["200"]},
+      "enforced_for": {"@@assign": ["secretsmanager:ALL_SUPPORTED"]},
+      "report_required_tag_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {
+      "tag_key": "Owner",
+      "report_required_tag_for": ["ec2:instance"]
+    },
+    "project": {
+      "enforced_for": "ec2:instance"
+    }
+  }
+}`))
+	if err != nil {
+		t.Fatalf("parsePolicy() error = %v", err)
+	}
+
+	if got, want := policy.RequiredTags["aws_instance"].Keys(), []string{"CostCenter", "Owner"}; !slices.Equal(slices.Sorted(slices.Values(got)), want) {
+		t.Errorf("RequiredTags[aws_instance] = %v, want %v", got, want)
+	}
+
+	wantRules := map[string][]tftags.TagPolicyRule{
+		"aws_instance": {
+			{Key: "project"},
+		},
+		"aws_secretsmanager_secret": {
+			{Key: "CostCenter", Values: []string{"100", "300*"}},
+		},
+	}
+	if diff := cmp.Diff(policy.EnforcedTagRules, wantRules); diff != "" {
+		t.Errorf("unexpected EnforcedTagRules diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestParsePolicy_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := parsePolicy(context.Background(), []byte(`{"tags": {"x": {"tag_value": {"@@assign": 1}}}}`)); err == nil {
+		t.Error("parsePolicy() expected error")
+	}
+}
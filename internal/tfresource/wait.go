@@ -59,6 +59,7 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func(context.Contex
 		Delay:                     opts.Delay,
 		MinTimeout:                opts.MinTimeout,
 		PollInterval:              opts.PollInterval,
+		Name:                      retry.CallerName(1),
 	}
 
 	_, err := stateConf.WaitForStateContext(ctx)
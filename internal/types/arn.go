@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+const (
+	// arnSections is the number of colon-separated sections in an ARN
+	arnSections = 6
+
+	// arnResourceSeparators are the characters separating an ARN resource type from its identifier
+	arnResourceSeparators = ":/"
+
+	// canonicalARNResourceSeparator is the resource type separator used by NormalizeARN
+	canonicalARNResourceSeparator = "/"
+)
+
+// iamPathResourceTypes are the IAM resource types whose identifiers may include a path.
+var iamPathResourceTypes = []string{
+	"group",
+	"instance-profile",
+	"policy",
+	"role",
+	"server-certificate",
+	"user",
+}
+
+// ParseARN parses an ARN.
+// This is the ARN parser shared by the ARN custom type and the ARN provider functions.
+func ParseARN(s string) (arn.ARN, error) {
+	return arn.Parse(s)
+}
+
+// ARNResource is the resource section of an ARN split into its resource type and identifier.
+type ARNResource struct {
+	Type      string
+	Separator string
+	ID        string
+}
+
+// ParseARNResource splits the resource section of an ARN at the first ':' or '/'.
+// Resources without a resource type, e.g. Amazon SNS topics, have an empty Type and Separator.
+func ParseARNResource(resource string) ARNResource {
+	i := strings.IndexAny(resource, arnResourceSeparators)
+	if i < 0 {
+		return ARNResource{ID: resource}
+	}
+
+	return ARNResource{
+		Type:      resource[:i],
+		Separator: resource[i : i+1],
+		ID:        resource[i+1:],
+	}
+}
+
+// NormalizeARN returns the canonical form of an ARN in any partition:
+// - The resource type and identifier are separated by '/'
+// - Any path is removed from IAM group, instance profile, policy, role, server certificate and user identifiers
+// The canonical form is intended for comparison and is not necessarily accepted by AWS APIs.
+func NormalizeARN(s string) (string, error) {
+	v, err := ParseARN(s)
+	if err != nil {
+		return "", err
+	}
+
+	r := ParseARNResource(v.Resource)
+	if r.Type == "" {
+		return v.String(), nil
+	}
+
+	if v.Service == "iam" && slices.Contains(iamPathResourceTypes, r.Type) {
+		if i := strings.LastIndex(r.ID, "/"); i >= 0 {
+			r.ID = r.ID[i+1:]
+		}
+	}
+
+	v.Resource = r.Type + canonicalARNResourceSeparator + r.ID
+
+	return v.String(), nil
+}
+
+// ARNMatch returns whether an ARN matches a pattern one colon-separated section at a time,
+// with IAM-style '*' and '?' wildcards within each section. The resource section is matched
+// as a whole and may itself contain colons.
+func ARNMatch(pattern, s string) (bool, error) {
+	pp := strings.SplitN(pattern, ":", arnSections)
+	if len(pp) != arnSections {
+		return false, fmt.Errorf("%q is not a valid ARN pattern: expected %d colon-separated sections", pattern, arnSections)
+	}
+
+	if _, err := ParseARN(s); err != nil {
+		return false, err
+	}
+
+	sp := strings.SplitN(s, ":", arnSections)
+	for i := range arnSections {
+		if !WildcardMatch(pp[i], sp[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
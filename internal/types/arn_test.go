@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseARNResource(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		resource string
+		expected ARNResource
+	}{
+		{"role/example", ARNResource{Type: "role", Separator: "/", ID: "example"}},
+		{"role/path/example", ARNResource{Type: "role", Separator: "/", ID: "path/example"}},
+		{"function:example:1", ARNResource{Type: "function", Separator: ":", ID: "example:1"}},
+		{"example-topic", ARNResource{ID: "example-topic"}},
+		{"", ARNResource{}},
+	} {
+		if got, want := ParseARNResource(tc.resource), tc.expected; !cmp.Equal(got, want) {
+			t.Errorf("ParseARNResource(%q) = %+v, want %+v", tc.resource, got, want)
+		}
+	}
+}
+
+func TestNormalizeARN(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		arn      string
+		expected string
+		wantErr  bool
+	}{
+		{"arn:aws:iam::444455556666:role/example", "arn:aws:iam::444455556666:role/example", false},
+		{"arn:aws:iam::444455556666:role/path/to/example", "arn:aws:iam::444455556666:role/example", false},
+		{"arn:aws-cn:iam::444455556666:policy/path/example", "arn:aws-cn:iam::444455556666:policy/example", false},
+		{"arn:aws-us-gov:lambda:us-gov-west-1:444455556666:function:example", "arn:aws-us-gov:lambda:us-gov-west-1:444455556666:function/example", false},
+		{"arn:aws:sns:us-west-2:444455556666:example", "arn:aws:sns:us-west-2:444455556666:example", false},
+		{"arn:aws:sts::444455556666:assumed-role/example/session", "arn:aws:sts::444455556666:assumed-role/example/session", false},
+		{"invalid", "", true},
+	} {
+		got, err := NormalizeARN(tc.arn)
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Errorf("NormalizeARN(%q) err %v, want error %t", tc.arn, err, tc.wantErr)
+			continue
+		}
+		if got != tc.expected {
+			t.Errorf("NormalizeARN(%q) = %q, want %q", tc.arn, got, tc.expected)
+		}
+	}
+}
+
+func TestARNMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern string
+		arn     string
+		match   bool
+		wantErr bool
+	}{
+		{"arn:aws:iam::444455556666:role/example", "arn:aws:iam::444455556666:role/example", true, false},
+		{"arn:*:iam::*:role/*", "arn:aws-cn:iam::444455556666:role/example", true, false},
+		{"arn:*:iam::*:role/*", "arn:aws:iam::444455556666:user/example", false, false},
+		{"arn:aws:*:*:44445555666?:*", "arn:aws:s3:::example", false, false},
+		{"arn:aws:*:*:44445555666?:*", "arn:aws:lambda:us-west-2:444455556666:function:example", true, false},
+		{"arn:aws:s3:::*", "arn:aws:s3:::example/key", true, false},
+		{"arn:aws:*", "arn:aws:s3:::example", false, true},
+		{"arn:aws:s3:::*", "invalid", false, true},
+	} {
+		got, err := ARNMatch(tc.pattern, tc.arn)
+		if gotErr := err != nil; gotErr != tc.wantErr {
+			t.Errorf("ARNMatch(%q, %q) err %v, want error %t", tc.pattern, tc.arn, err, tc.wantErr)
+			continue
+		}
+		if got != tc.match {
+			t.Errorf("ARNMatch(%q, %q) = %t, want %t", tc.pattern, tc.arn, got, tc.match)
+		}
+	}
+}
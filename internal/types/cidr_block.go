@@ -114,6 +114,33 @@ func CIDRBlocksOverlap(cidr1, cidr2 string) bool {
 	return net1.Contains(ip2) || net1.Contains(getLastIP(net2))
 }
 
+// CIDRBlockContains returns whether the first CIDR block fully contains the second CIDR block.
+// Both CIDR blocks must be of the same address family.
+// Returns false if either CIDR block cannot be parsed.
+func CIDRBlockContains(cidr1, cidr2 string) bool {
+	_, net1, err := net.ParseCIDR(cidr1)
+	if err != nil {
+		return false
+	}
+
+	_, net2, err := net.ParseCIDR(cidr2)
+	if err != nil {
+		return false
+	}
+
+	ones1, bits1 := net1.Mask.Size()
+	ones2, bits2 := net2.Mask.Size()
+
+	return bits1 == bits2 && ones1 <= ones2 && net1.Contains(net2.IP)
+}
+
+// CIDRBlocksIntersect returns whether two CIDR blocks share any addresses.
+// Unlike CIDRBlocksOverlap, the result does not depend on argument order.
+// Returns false if either CIDR block cannot be parsed.
+func CIDRBlocksIntersect(cidr1, cidr2 string) bool {
+	return CIDRBlockContains(cidr1, cidr2) || CIDRBlockContains(cidr2, cidr1)
+}
+
 // IsIPv4CIDR returns whether a CIDR block is IPv4.
 func IsIPv4CIDR(cidr string) bool {
 	_, ipNet, err := net.ParseCIDR(cidr)
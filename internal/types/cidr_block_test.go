@@ -166,6 +166,54 @@ func TestCIDRBlocksOverlap(t *testing.T) {
 	}
 }
 
+func TestCIDRBlockContains(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1    string
+		cidr2    string
+		contains bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},            // cidr2 is subnet within cidr1
+		{"10.0.0.0/16", "10.0.0.0/16", true},            // identical CIDRs
+		{"10.0.1.0/24", "10.0.0.0/16", false},           // smaller CIDR cannot contain larger
+		{"10.0.0.0/24", "10.0.1.0/24", false},           // adjacent subnets
+		{"10.0.0.0/16", "10.0.0.5/32", true},            // single address
+		{"2001:db8::/56", "2001:db8:0:ff::/64", true},   // IPv6 subnet at edge of range
+		{"2001:db8::/56", "2001:db8:0:100::/64", false}, // IPv6 subnet outside range
+		{"::/0", "10.0.0.0/8", false},                   // different address families
+		{"0.0.0.0/0", "::/0", false},                    // different address families
+		{"not-a-cidr", "10.0.0.0/24", false},            // invalid cidr1
+		{"10.0.0.0/24", "", false},                      // invalid cidr2
+	} {
+		if got, want := CIDRBlockContains(ts.cidr1, ts.cidr2), ts.contains; got != want {
+			t.Errorf("CIDRBlockContains(%q, %q) = %t, want %t", ts.cidr1, ts.cidr2, got, want)
+		}
+	}
+}
+
+func TestCIDRBlocksIntersect(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr1      string
+		cidr2      string
+		intersects bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.1.0/24", "10.0.0.0/16", true},
+		{"10.0.0.0/24", "10.0.1.0/24", false},
+		{"2001:db8::/64", "2001:db8::/56", true},
+		{"2001:db8::/64", "2001:db8:0:1::/64", false},
+		{"10.0.0.0/8", "::/0", false},
+		{"", "10.0.0.0/8", false},
+	} {
+		if got, want := CIDRBlocksIntersect(ts.cidr1, ts.cidr2), ts.intersects; got != want {
+			t.Errorf("CIDRBlocksIntersect(%q, %q) = %t, want %t", ts.cidr1, ts.cidr2, got, want)
+		}
+	}
+}
+
 func TestIsIPv4CIDR(t *testing.T) {
 	t.Parallel()
 
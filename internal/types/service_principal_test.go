@@ -3,7 +3,11 @@
 
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
 
 func TestIsServicePrincipal(t *testing.T) {
 	t.Parallel()
@@ -58,3 +62,31 @@ func TestIsServicePrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestServicePrincipalSuffix(t *testing.T) {
+	t.Parallel()
+
+	partition := func(region string) endpoints.Partition {
+		p, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+		return p
+	}
+
+	for _, tc := range []struct {
+		service  string
+		region   string
+		expected string
+	}{
+		{"logs", "us-west-2", "amazonaws.com"},
+		{"logs", "cn-north-1", "amazonaws.com.cn"},
+		{"ec2", "cn-north-1", "amazonaws.com"},
+		{"codedeploy", "cn-northwest-1", "amazonaws.com.cn"},
+		{"logs", "us-gov-west-1", "amazonaws.com"},
+		{"config", "us-iso-east-1", "c2s.ic.gov"},
+		{"dms", "us-isob-east-1", "sc2s.sgov.gov"},
+		{"", "cn-north-1", "amazonaws.com"},
+	} {
+		if got, want := ServicePrincipalSuffix(tc.service, partition(tc.region)), tc.expected; got != want {
+			t.Errorf("ServicePrincipalSuffix(%q, %q) = %q, want %q", tc.service, tc.region, got, want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+// WildcardMatch returns whether s matches an IAM-style pattern in which
+// '*' matches any sequence of characters (including none) and '?' matches any single character.
+// Matching is case-sensitive.
+func WildcardMatch(pattern, s string) bool {
+	p, str := []rune(pattern), []rune(s)
+	var pi, si int
+	star, mark := -1, 0
+
+	for si < len(str) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == str[si]):
+			pi++
+			si++
+		case pi < len(p) && p[pi] == '*':
+			star, mark = pi, si
+			pi++
+		case star != -1:
+			// backtrack, letting the last '*' consume one more character
+			pi = star + 1
+			mark++
+			si = mark
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "testing"
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{"", "", true},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"s3:Get*", "S3:GetObject", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"a*b?c", "axxbyc", true},
+		{"a*b?c", "axxbc", false},
+		{"*/*", "role/example", true},
+		{"*/*", "example", false},
+		{"ab*cd*", "abxcdxcdy", true},
+		{"abc", "abcd", false},
+	} {
+		if got, want := WildcardMatch(tc.pattern, tc.s), tc.match; got != want {
+			t.Errorf("WildcardMatch(%q, %q) = %v, want %v", tc.pattern, tc.s, got, want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// cassettelint reports VCR cassettes that contain material, such as credentials, account IDs or
+// secret values, that the default redaction rules would redact.
+//
+// Usage:
+//
+//	go run ./internal/vcr/cassettelint [path ...]
+//
+// Each path is a cassette file or a directory that is searched for cassettes.
+// If no paths are specified, the directory named by VCR_PATH is searched.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const cassetteExtension = ".yaml"
+
+func main() {
+	log.SetFlags(0)
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		if vcr.Path() == "" {
+			log.Fatal("no cassette paths specified and VCR_PATH is not set")
+		}
+		paths = []string{vcr.Path()}
+	}
+
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() && filepath.Ext(path) == cassetteExtension {
+				files = append(files, path)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			log.Fatalf("finding cassettes in %s: %s", path, err)
+		}
+	}
+
+	failed := false
+	for _, file := range files {
+		findings, err := lint(file)
+
+		if err != nil {
+			log.Fatalf("linting %s: %s", file, err)
+		}
+
+		for _, finding := range findings {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", file, finding)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+
+	fmt.Printf("Linted %d cassettes\n", len(files))
+}
+
+// lint returns the findings for all interactions in a cassette file.
+// Rules are created for each cassette as some, such as account ID redaction, are stateful.
+func lint(file string) ([]string, error) {
+	c, err := cassette.Load(strings.TrimSuffix(file, cassetteExtension))
+
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := vcr.NewRedactor(vcr.DefaultRedactionRules()...)
+
+	var findings []string
+	for _, i := range c.Interactions {
+		for _, finding := range redactor.Lint(i) {
+			findings = append(findings, fmt.Sprintf("interaction %d: %s", i.ID, finding))
+		}
+	}
+
+	return findings, nil
+}
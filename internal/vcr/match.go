@@ -0,0 +1,485 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// Protocol is a Smithy AWS protocol.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+type Protocol string
+
+const (
+	ProtocolUnknown   Protocol = ""
+	ProtocolAWSJSON10 Protocol = "awsJson1_0"
+	ProtocolAWSJSON11 Protocol = "awsJson1_1"
+	ProtocolRESTJSON1 Protocol = "restJson1"
+	ProtocolRESTXML   Protocol = "restXml"
+	ProtocolAWSQuery  Protocol = "awsQuery"
+	ProtocolEC2Query  Protocol = "ec2Query"
+)
+
+var (
+	serviceProtocolsLock sync.Mutex
+	serviceProtocols     = map[string]Protocol{
+		// EC2 is the only ec2Query service, and its requests are otherwise indistinguishable from awsQuery requests.
+		"EC2": ProtocolEC2Query,
+	}
+)
+
+// RegisterServiceProtocol registers the protocol used by the AWS SDK for Go v2 client with the specified service ID.
+// Registration is only needed when a service's protocol can't be determined from its requests' headers.
+func RegisterServiceProtocol(serviceID string, protocol Protocol) {
+	serviceProtocolsLock.Lock()
+	defer serviceProtocolsLock.Unlock()
+
+	serviceProtocols[serviceID] = protocol
+}
+
+// RequestProtocol returns the protocol of an HTTP request made by an AWS SDK for Go v2 client.
+// The protocol registered for the client's service ID is used if there is one, otherwise the protocol is
+// determined from the request's headers.
+func RequestProtocol(r *http.Request) Protocol {
+	if serviceID := awsmiddleware.GetServiceID(r.Context()); serviceID != "" {
+		serviceProtocolsLock.Lock()
+		protocol, ok := serviceProtocols[serviceID]
+		serviceProtocolsLock.Unlock()
+
+		if ok {
+			return protocol
+		}
+	}
+
+	contentType, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";")
+
+	switch contentType = strings.TrimSpace(contentType); contentType {
+	case "application/x-amz-json-1.0":
+		return ProtocolAWSJSON10
+	case "application/x-amz-json-1.1":
+		return ProtocolAWSJSON11
+	case "application/x-www-form-urlencoded":
+		return ProtocolAWSQuery
+	case "application/json":
+		if r.Header.Get("X-Amz-Target") != "" {
+			return ProtocolAWSJSON11
+		}
+		return ProtocolRESTJSON1
+	case "application/xml", "text/xml":
+		return ProtocolRESTXML
+	}
+
+	return ProtocolUnknown
+}
+
+// VolatileFields configures request values that differ between test runs and are ignored when matching
+// requests to recorded interactions.
+type VolatileFields struct {
+	// Names are the names of JSON object members, XML elements and query parameters whose values are ignored.
+	// Names are case-insensitive.
+	Names []string
+
+	// Values are patterns matching volatile parts of string values and URLs.
+	Values []*regexp.Regexp
+}
+
+// DefaultVolatileFields returns the default volatile fields: idempotency tokens, timestamps and
+// the unique ID suffixes generated by the Terraform Plugin SDK.
+func DefaultVolatileFields() VolatileFields {
+	return VolatileFields{
+		Names: []string{
+			"CallerReference",
+			"ClientRequestToken",
+			"ClientToken",
+			"IdempotencyToken",
+		},
+		Values: []*regexp.Regexp{
+			// Unique ID suffixes, see https://pkg.go.dev/github.com/hashicorp/terraform-plugin-sdk/v2/helper/id#PrefixedUniqueId.
+			regexache.MustCompile(`\d{18}[0-9a-f]{8}`),
+			// RFC 3339 timestamps.
+			regexache.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:?\d{2})`),
+		},
+	}
+}
+
+const volatileValue = "<volatile>"
+
+// Matcher matches HTTP requests to recorded interactions.
+// Requests are redacted in the same way as recorded interactions, and bodies are compared after being
+// canonicalized according to the request's protocol.
+type Matcher struct {
+	redactor *Redactor
+	names    map[string]struct{}
+	values   []*regexp.Regexp
+
+	lock     sync.Mutex
+	requests map[*http.Request]*matchState // Requests being matched, see Done
+}
+
+// matchState is the state of matching a request that has not yet been matched.
+type matchState struct {
+	protocol  Protocol
+	url       string
+	body      string
+	canonical any
+	operation string
+
+	// candidate is the first recorded request for the same operation that did not match.
+	candidate *cassette.Request
+}
+
+// NewMatcher returns a Matcher that redacts requests with the specified redactor and ignores the specified
+// volatile fields.
+func NewMatcher(redactor *Redactor, volatile VolatileFields) *Matcher {
+	m := &Matcher{
+		redactor: redactor,
+		names:    make(map[string]struct{}),
+		values:   volatile.Values,
+		requests: make(map[*http.Request]*matchState),
+	}
+
+	for _, name := range volatile.Names {
+		m.names[strings.ToLower(name)] = struct{}{}
+	}
+
+	return m
+}
+
+// Match is a go-vcr recorder.MatcherFunc.
+func (m *Matcher) Match(r *http.Request, i cassette.Request) bool {
+	if r.Method != i.Method {
+		return false
+	}
+
+	state, err := m.state(r)
+	if err != nil {
+		return false
+	}
+
+	if state.url != m.normalizeString(i.URL) {
+		return false
+	}
+
+	if state.body == i.Body || state.canonical != nil && cmp.Equal(state.canonical, m.canonicalize(state.protocol, i.Body)) {
+		m.lock.Lock()
+		delete(m.requests, r)
+		m.lock.Unlock()
+
+		return true
+	}
+
+	if state.candidate == nil && state.operation == m.operation(state.protocol, i) {
+		state.candidate = &i
+	}
+
+	return false
+}
+
+// Mismatch returns a readable description of why a request did not match any recorded interaction.
+// It must only be called once the request has been compared with all recorded interactions.
+func (m *Matcher) Mismatch(r *http.Request) string {
+	m.lock.Lock()
+	state, ok := m.requests[r]
+	delete(m.requests, r)
+	m.lock.Unlock()
+
+	if !ok {
+		return ""
+	}
+
+	if state.candidate == nil {
+		return fmt.Sprintf("no recorded %s request to %s", r.Method, state.url)
+	}
+
+	var diff string
+	if state.canonical != nil {
+		diff = cmp.Diff(m.canonicalize(state.protocol, state.candidate.Body), state.canonical)
+	} else {
+		diff = cmp.Diff(state.candidate.Body, state.body)
+	}
+
+	return fmt.Sprintf("%s request body differs from the closest recorded interaction (-recorded +request):\n%s", state.protocol, diff)
+}
+
+// Done discards the matching state of a request once matching has ended, whether or not the request matched.
+// It must be called after each request is matched so that the state of requests that are abandoned,
+// for example when they are canceled or retried, is not retained.
+func (m *Matcher) Done(r *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.requests, r)
+}
+
+// state returns the matching state of a request, creating it the first time the request is matched.
+func (m *Matcher) state(r *http.Request) (*matchState, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if state, ok := m.requests[r]; ok {
+		return state, nil
+	}
+
+	var body string
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(b))
+		body = string(b)
+	}
+
+	u, body := m.redactor.RedactRequest(r, body)
+	protocol := RequestProtocol(r)
+
+	state := &matchState{
+		protocol:  protocol,
+		url:       m.normalizeString(u),
+		body:      body,
+		canonical: m.canonicalize(protocol, body),
+		operation: m.operation(protocol, cassette.Request{Body: body, Headers: r.Header}),
+	}
+	m.requests[r] = state
+
+	return state, nil
+}
+
+// operation returns the name of the operation for RPC-style protocols.
+func (m *Matcher) operation(protocol Protocol, r cassette.Request) string {
+	switch protocol {
+	case ProtocolAWSJSON10, ProtocolAWSJSON11:
+		return r.Headers.Get("X-Amz-Target")
+	case ProtocolAWSQuery, ProtocolEC2Query:
+		if v, err := url.ParseQuery(r.Body); err == nil {
+			return v.Get("Action")
+		}
+	}
+
+	return ""
+}
+
+// canonicalize returns a canonical form of a request body, or nil if the body can't be decoded.
+// In the canonical form object members, XML elements and query parameters are unordered,
+// list elements are sorted and volatile fields are ignored.
+// List elements are unordered because many lists are built from Go maps.
+func (m *Matcher) canonicalize(protocol Protocol, body string) any {
+	if body == "" {
+		return nil
+	}
+
+	var (
+		v   any
+		err error
+	)
+
+	switch protocol {
+	case ProtocolAWSJSON10, ProtocolAWSJSON11, ProtocolRESTJSON1:
+		v, err = decodeJSON(body)
+	case ProtocolRESTXML:
+		v, err = decodeXML(body)
+	case ProtocolAWSQuery, ProtocolEC2Query:
+		v, err = decodeQuery(body)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return nil
+	}
+
+	return m.normalize(v)
+}
+
+func (m *Matcher) normalize(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := m.names[strings.ToLower(k)]; ok {
+				v[k] = volatileValue
+			} else {
+				v[k] = m.normalize(e)
+			}
+		}
+		return v
+
+	case []any:
+		type element struct {
+			key   string
+			value any
+		}
+		elements := make([]element, len(v))
+		for idx, e := range v {
+			e = m.normalize(e)
+			b, _ := json.Marshal(e)
+			elements[idx] = element{key: string(b), value: e}
+		}
+		slices.SortStableFunc(elements, func(a, b element) int {
+			return strings.Compare(a.key, b.key)
+		})
+		for idx, e := range elements {
+			v[idx] = e.value
+		}
+		return v
+
+	case string:
+		return m.normalizeString(v)
+	}
+
+	return v
+}
+
+func (m *Matcher) normalizeString(s string) string {
+	for _, re := range m.values {
+		s = re.ReplaceAllString(s, volatileValue)
+	}
+
+	return s
+}
+
+func decodeJSON(body string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// decodeXML decodes an XML document into a map of element names to lists of child element values.
+// Elements without child elements are decoded to their text and attributes are decoded as "@name" members.
+func decodeXML(body string) (any, error) {
+	type element struct {
+		children map[string]any
+		text     strings.Builder
+	}
+
+	root := &element{children: make(map[string]any)}
+	stack := []*element{root}
+	names := []string{""}
+
+	dec := xml.NewDecoder(strings.NewReader(body))
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &element{children: make(map[string]any)}
+			for _, attr := range token.Attr {
+				e.children["@"+attr.Name.Local] = attr.Value
+			}
+			stack = append(stack, e)
+			names = append(names, token.Name.Local)
+
+		case xml.CharData:
+			stack[len(stack)-1].text.Write(token)
+
+		case xml.EndElement:
+			e, name := stack[len(stack)-1], names[len(names)-1]
+			stack, names = stack[:len(stack)-1], names[:len(names)-1]
+
+			var v any
+			if len(e.children) == 0 {
+				v = strings.TrimSpace(e.text.String())
+			} else {
+				v = e.children
+			}
+
+			parent := stack[len(stack)-1].children
+			values, _ := parent[name].([]any)
+			parent[name] = append(values, v)
+		}
+	}
+
+	return root.children, nil
+}
+
+// decodeQuery decodes an awsQuery or ec2Query body into nested maps and lists.
+// For example, "Tags.member.1.Key=k" is decoded to {"Tags": {"member": [{"Key": "k"}]}}.
+func decodeQuery(body string) (any, error) {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return nil, err
+	}
+
+	root := make(map[string]any)
+	for k, v := range values {
+		node := root
+		segments := strings.Split(k, ".")
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				if leaf, ok := node[segment]; ok {
+					child[""] = leaf
+				}
+				node[segment] = child
+			}
+			node = child
+		}
+
+		leaf := segments[len(segments)-1]
+		if child, ok := node[leaf].(map[string]any); ok {
+			child[""] = strings.Join(v, ",")
+		} else {
+			node[leaf] = strings.Join(v, ",")
+		}
+	}
+
+	return queryLists(root), nil
+}
+
+// queryLists replaces maps whose keys are all list indexes with lists.
+func queryLists(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	isList := len(m) > 0
+	for k, e := range m {
+		m[k] = queryLists(e)
+		if _, err := strconv.Atoi(k); err != nil {
+			isList = false
+		}
+	}
+
+	if !isList {
+		return m
+	}
+
+	list := make([]any, 0, len(m))
+	for _, e := range m {
+		list = append(list, e)
+	}
+
+	return list
+}
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestRequestProtocol(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		serviceID string
+		headers   http.Header
+		want      Protocol
+	}{
+		{
+			name:    "awsJson1_0",
+			headers: http.Header{"Content-Type": {"application/x-amz-json-1.0"}, "X-Amz-Target": {"DynamoDB_20120810.GetItem"}},
+			want:    ProtocolAWSJSON10,
+		},
+		{
+			name:    "awsJson1_1",
+			headers: http.Header{"Content-Type": {"application/x-amz-json-1.1"}, "X-Amz-Target": {"Logs_20140328.CreateLogGroup"}},
+			want:    ProtocolAWSJSON11,
+		},
+		{
+			name:    "restJson1",
+			headers: http.Header{"Content-Type": {"application/json"}},
+			want:    ProtocolRESTJSON1,
+		},
+		{
+			name:    "restXml",
+			headers: http.Header{"Content-Type": {"application/xml"}},
+			want:    ProtocolRESTXML,
+		},
+		{
+			name:    "awsQuery",
+			headers: http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}},
+			want:    ProtocolAWSQuery,
+		},
+		{
+			name:      "ec2Query",
+			serviceID: "EC2",
+			headers:   http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}},
+			want:      ProtocolEC2Query,
+		},
+		{
+			name: "unknown",
+			want: ProtocolUnknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testRequest(t.Context(), testCase.serviceID, http.MethodPost, "https://example.amazonaws.com/", testCase.headers, "")
+
+			if got, want := RequestProtocol(r), testCase.want; got != want {
+				t.Errorf("RequestProtocol() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestMatcherMatch(t *testing.T) {
+	t.Parallel()
+
+	jsonHeaders := http.Header{"Content-Type": {"application/x-amz-json-1.1"}, "X-Amz-Target": {"SecretsManager.CreateSecret"}}
+	queryHeaders := http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}}
+	xmlHeaders := http.Header{"Content-Type": {"application/xml"}}
+
+	testCases := []struct {
+		name      string
+		serviceID string
+		headers   http.Header
+		url       string
+		body      string
+		recorded  cassette.Request
+		want      bool
+	}{
+		{
+			name:    "awsJson reordered with volatile token",
+			headers: jsonHeaders,
+			body:    `{"Name":"example","ClientRequestToken":"a","Tags":[{"Key":"k2","Value":"v2"},{"Key":"k1","Value":"v1"}]}`,
+			recorded: cassette.Request{
+				Body:    `{"ClientRequestToken":"b","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}],"Name":"example"}`,
+				Headers: jsonHeaders,
+			},
+			want: true,
+		},
+		{
+			name:    "awsJson different value",
+			headers: jsonHeaders,
+			body:    `{"Name":"example"}`,
+			recorded: cassette.Request{
+				Body:    `{"Name":"other"}`,
+				Headers: jsonHeaders,
+			},
+		},
+		{
+			name:    "awsJson redacted secret",
+			headers: jsonHeaders,
+			body:    `{"Name":"example","SecretString":"hunter2"}`,
+			recorded: cassette.Request{
+				Body:    `{"Name":"example","SecretString":"REDACTED"}`,
+				Headers: jsonHeaders,
+			},
+			want: true,
+		},
+		{
+			name:    "awsQuery reordered list",
+			headers: queryHeaders,
+			body:    `Action=CreateTopic&Name=example&Tags.member.1.Key=k2&Tags.member.1.Value=v2&Tags.member.2.Key=k1&Tags.member.2.Value=v1&Version=2010-03-31`,
+			recorded: cassette.Request{
+				Body: `Action=CreateTopic&Name=example&Tags.member.1.Key=k1&Tags.member.1.Value=v1&Tags.member.2.Key=k2&Tags.member.2.Value=v2&Version=2010-03-31`,
+			},
+			want: true,
+		},
+		{
+			name:      "ec2Query volatile token",
+			serviceID: "EC2",
+			headers:   queryHeaders,
+			body:      `Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=terraform-20260101120000000000000001&Version=2016-11-15`,
+			recorded: cassette.Request{
+				Body: `Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=terraform-20260102120000000000000002&Version=2016-11-15`,
+			},
+			want: true,
+		},
+		{
+			name:    "restXml reordered elements",
+			headers: xmlHeaders,
+			url:     "https://example.s3.us-west-2.amazonaws.com/?tagging",
+			body:    `<Tagging><TagSet><Tag><Key>k2</Key><Value>v2</Value></Tag><Tag><Key>k1</Key><Value>v1</Value></Tag></TagSet></Tagging>`,
+			recorded: cassette.Request{
+				Body: `<Tagging><TagSet><Tag><Key>k1</Key><Value>v1</Value></Tag><Tag><Key>k2</Key><Value>v2</Value></Tag></TagSet></Tagging>`,
+				URL:  "https://example.s3.us-west-2.amazonaws.com/?tagging",
+			},
+			want: true,
+		},
+		{
+			name:    "restJson unique ID in URL",
+			headers: http.Header{"Content-Type": {"application/json"}},
+			url:     "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/terraform-20260101120000000000000001",
+			body:    `{"Timeout":3}`,
+			recorded: cassette.Request{
+				Body: `{"Timeout":3}`,
+				URL:  "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/terraform-20260102120000000000000002",
+			},
+			want: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if testCase.url == "" {
+				testCase.url = "https://example.us-west-2.amazonaws.com/"
+			}
+			if testCase.recorded.URL == "" {
+				testCase.recorded.URL = testCase.url
+			}
+			testCase.recorded.Method = http.MethodPost
+
+			m := NewMatcher(NewRedactor(DefaultRedactionRules()...), DefaultVolatileFields())
+			r := testRequest(t.Context(), testCase.serviceID, http.MethodPost, testCase.url, testCase.headers, testCase.body)
+
+			if got, want := m.Match(r, testCase.recorded), testCase.want; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestMatcherMismatch(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{"Content-Type": {"application/x-amz-json-1.0"}, "X-Amz-Target": {"DynamoDB_20120810.CreateTable"}}
+	m := NewMatcher(NewRedactor(DefaultRedactionRules()...), DefaultVolatileFields())
+	r := testRequest(t.Context(), "", http.MethodPost, "https://dynamodb.us-west-2.amazonaws.com/", headers, `{"TableName":"example","BillingMode":"PROVISIONED"}`)
+
+	for _, recorded := range []cassette.Request{
+		{
+			Body:    `{"TableName":"example"}`,
+			Headers: http.Header{"X-Amz-Target": {"DynamoDB_20120810.DescribeTable"}},
+			Method:  http.MethodPost,
+			URL:     "https://dynamodb.us-west-2.amazonaws.com/",
+		},
+		{
+			Body:    `{"TableName":"example","BillingMode":"PAY_PER_REQUEST"}`,
+			Headers: headers,
+			Method:  http.MethodPost,
+			URL:     "https://dynamodb.us-west-2.amazonaws.com/",
+		},
+	} {
+		if m.Match(r, recorded) {
+			t.Fatal("unexpected match")
+		}
+	}
+
+	got := m.Mismatch(r)
+	for _, want := range []string{"awsJson1_0", "PAY_PER_REQUEST", "PROVISIONED"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Mismatch() does not contain %q:\n%s", want, got)
+		}
+	}
+
+	if got := m.Mismatch(r); got != "" {
+		t.Errorf("Mismatch() called twice = %q, want empty", got)
+	}
+}
+
+func TestMatcherDone(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{"Content-Type": {"application/x-amz-json-1.0"}, "X-Amz-Target": {"DynamoDB_20120810.DescribeTable"}}
+	m := NewMatcher(NewRedactor(DefaultRedactionRules()...), DefaultVolatileFields())
+	recorded := cassette.Request{
+		Body:    `{"TableName":"other"}`,
+		Headers: headers,
+		Method:  http.MethodPost,
+		URL:     "https://dynamodb.us-west-2.amazonaws.com/",
+	}
+
+	// Requests that are abandoned after failing to match, e.g. retries, are not retained once matching has ended.
+	for range 3 {
+		r := testRequest(t.Context(), "", http.MethodPost, "https://dynamodb.us-west-2.amazonaws.com/", headers, `{"TableName":"example"}`)
+		if m.Match(r, recorded) {
+			t.Fatal("unexpected match")
+		}
+		m.Done(r)
+	}
+
+	if got := len(m.requests); got != 0 {
+		t.Errorf("retained requests: got %d, want 0", got)
+	}
+}
+
+func testRequest(ctx context.Context, serviceID, method, url string, headers http.Header, body string) *http.Request {
+	if serviceID != "" {
+		ctx = awsmiddleware.SetServiceID(ctx, serviceID)
+	}
+
+	r, _ := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+	r.Header = headers.Clone()
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+
+	return r
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// Code generated by internal/generate/policylint/actions.go; DO NOT EDIT.
+
+package policylint
+
+// catalog maps IAM service prefix to the actions that service supports
+var catalog = map[string][]string{
+	"sns": {
+		"AddPermission",
+		"CheckIfPhoneNumberIsOptedOut",
+		"ConfirmSubscription",
+		"CreatePlatformApplication",
+		"CreatePlatformEndpoint",
+		"CreateSMSSandboxPhoneNumber",
+		"CreateTopic",
+		"DeleteDataProtectionPolicy",
+		"DeleteEndpoint",
+		"DeletePlatformApplication",
+		"DeleteSMSSandboxPhoneNumber",
+		"DeleteTopic",
+		"GetDataProtectionPolicy",
+		"GetEndpointAttributes",
+		"GetPlatformApplicationAttributes",
+		"GetSMSAttributes",
+		"GetSMSSandboxAccountStatus",
+		"GetSubscriptionAttributes",
+		"GetTopicAttributes",
+		"ListEndpointsByPlatformApplication",
+		"ListOriginationNumbers",
+		"ListPhoneNumbersOptedOut",
+		"ListPlatformApplications",
+		"ListSMSSandboxPhoneNumbers",
+		"ListSubscriptions",
+		"ListSubscriptionsByTopic",
+		"ListTagsForResource",
+		"ListTopics",
+		"OptInPhoneNumber",
+		"Publish",
+		"PutDataProtectionPolicy",
+		"RemovePermission",
+		"SetEndpointAttributes",
+		"SetPlatformApplicationAttributes",
+		"SetSMSAttributes",
+		"SetSubscriptionAttributes",
+		"SetTopicAttributes",
+		"Subscribe",
+		"TagResource",
+		"Unsubscribe",
+		"UntagResource",
+		"VerifySMSSandboxPhoneNumber",
+	},
+	"sqs": {
+		"AddPermission",
+		"CancelMessageMoveTask",
+		"ChangeMessageVisibility",
+		"CreateQueue",
+		"DeleteMessage",
+		"DeleteQueue",
+		"GetQueueAttributes",
+		"GetQueueUrl",
+		"ListDeadLetterSourceQueues",
+		"ListMessageMoveTasks",
+		"ListQueueTags",
+		"ListQueues",
+		"PurgeQueue",
+		"ReceiveMessage",
+		"RemovePermission",
+		"SendMessage",
+		"SetQueueAttributes",
+		"StartMessageMoveTask",
+		"TagQueue",
+		"UntagQueue",
+	},
+	"sts": {
+		"AssumeRole",
+		"AssumeRoleWithSAML",
+		"AssumeRoleWithWebIdentity",
+		"AssumeRoot",
+		"DecodeAuthorizationMessage",
+		"GetAccessKeyInfo",
+		"GetCallerIdentity",
+		"GetDelegatedAccessToken",
+		"GetFederationToken",
+		"GetServiceBearerToken",
+		"GetSessionToken",
+		"GetWebIdentityToken",
+		"SetContext",
+		"SetSourceIdentity",
+		"TagSession",
+	},
+}
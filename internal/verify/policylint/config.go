@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policylint
+
+const (
+	// EnvVar is the environment variable used to configure policy linting
+	// when the provider's policy_lint argument is unset.
+	EnvVar = "TF_AWS_POLICY_LINT"
+)
+
+// Config contains options related to plan-time IAM policy linting.
+type Config struct {
+	// Severity is the severity with which findings are reported, either "error" or "warning".
+	Severity string
+}
+
+// sizeLimits are the maximum policy document sizes, in characters excluding
+// whitespace, enforced by AWS for each resource type's policy.
+var sizeLimits = map[string]int{
+	"aws_iam_group_policy":             5120,
+	"aws_iam_policy":                   6144,
+	"aws_iam_role_policy":              10240,
+	"aws_iam_user_policy":              2048,
+	"aws_kms_key":                      32768,
+	"aws_kms_key_policy":               32768,
+	"aws_s3_bucket_policy":             20480,
+	"aws_secretsmanager_secret":        20480,
+	"aws_secretsmanager_secret_policy": 20480,
+	"aws_sns_topic":                    30720,
+	"aws_sns_topic_policy":             30720,
+}
+
+// SizeLimit returns the maximum policy document size for the specified resource type.
+// Zero is returned if the size is not checked.
+func SizeLimit(typeName string) int {
+	return sizeLimits[typeName]
+}
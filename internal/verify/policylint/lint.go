@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policylint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Finding is a problem detected in a policy document.
+type Finding struct {
+	Summary string
+	Detail  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s - %s", f.Summary, f.Detail)
+}
+
+const (
+	summaryInvalidEffect             = "Invalid Effect"
+	summaryInvalidAction             = "Invalid Action"
+	summaryUnknownAction             = "Unknown Action"
+	summaryInvalidConditionOperator  = "Invalid Condition Operator"
+	summaryMalformedARN              = "Malformed ARN"
+	summaryUnconditionalPublicAccess = "Unconditional Public Access"
+	summaryPolicyTooLarge            = "Policy Too Large"
+)
+
+const (
+	wildcard = "*"
+)
+
+var (
+	servicePrefixRegexp = regexache.MustCompile(`^[a-z0-9-]+$`)
+	actionNameRegexp    = regexache.MustCompile(`^[A-Za-z0-9*?]+$`)
+)
+
+// conditionOperators are the base IAM condition operators, keyed by lower case name.
+var conditionOperators = func() map[string]bool {
+	operators := make(map[string]bool)
+	for _, v := range []string{
+		"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+		"BinaryEquals",
+		"Bool",
+		"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+		"IpAddress", "NotIpAddress",
+		"Null",
+		"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+		"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+	} {
+		operators[strings.ToLower(v)] = true
+	}
+	return operators
+}()
+
+// Lint statically checks an IAM policy JSON document for problems that AWS
+// would otherwise only report when the policy is applied.
+// maxSize is the maximum document size in characters excluding whitespace, or 0 to skip the size check.
+// Documents that are not valid JSON produce no findings as they are reported by schema validation.
+func Lint(document string, maxSize int) []Finding {
+	var doc policyDocument
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return nil
+	}
+
+	var findings []Finding
+
+	if maxSize > 0 {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(document)); err == nil {
+			if size := utf8.RuneCount(buf.Bytes()); size > maxSize {
+				findings = append(findings, Finding{
+					Summary: summaryPolicyTooLarge,
+					Detail:  fmt.Sprintf("policy is %d characters, exceeding the limit of %d", size, maxSize),
+				})
+			}
+		}
+	}
+
+	for i, statement := range doc.Statements {
+		findings = append(findings, statement.lint(i)...)
+	}
+
+	return findings
+}
+
+type policyDocument struct {
+	Statements policyStatements `json:"Statement"`
+}
+
+type policyStatements []*policyStatement
+
+func (ss *policyStatements) UnmarshalJSON(b []byte) error {
+	var list []*policyStatement
+	if err := json.Unmarshal(b, &list); err == nil {
+		*ss = list
+		return nil
+	}
+
+	var single policyStatement
+	if err := json.Unmarshal(b, &single); err != nil {
+		return err
+	}
+
+	*ss = policyStatements{&single}
+	return nil
+}
+
+type policyStatement struct {
+	Sid         string
+	Effect      string
+	Principal   json.RawMessage
+	Action      policyStringSet
+	NotAction   policyStringSet
+	Resource    policyStringSet
+	NotResource policyStringSet
+	Condition   map[string]json.RawMessage
+}
+
+func (s *policyStatement) lint(index int) []Finding {
+	var findings []Finding
+
+	name := fmt.Sprintf("statement %d", index)
+	if s.Sid != "" {
+		name = fmt.Sprintf("statement %q", s.Sid)
+	}
+	add := func(summary, format string, a ...any) {
+		findings = append(findings, Finding{
+			Summary: summary,
+			Detail:  name + ": " + fmt.Sprintf(format, a...),
+		})
+	}
+
+	if s.Effect != "Allow" && s.Effect != "Deny" {
+		add(summaryInvalidEffect, `Effect %q must be "Allow" or "Deny"`, s.Effect)
+	}
+
+	for _, action := range slices.Concat(s.Action, s.NotAction) {
+		if action == wildcard {
+			continue
+		}
+
+		service, name, ok := strings.Cut(action, ":")
+		if !ok || !servicePrefixRegexp.MatchString(service) || !actionNameRegexp.MatchString(name) {
+			add(summaryInvalidAction, `action %q must be of the form "service:ActionName"`, action)
+			continue
+		}
+
+		if !isKnownAction(service, name) {
+			add(summaryUnknownAction, "action %q does not match any %s action", action, service)
+		}
+	}
+
+	for _, resource := range slices.Concat(s.Resource, s.NotResource) {
+		if resource == wildcard {
+			continue
+		}
+
+		if _, err := inttypes.ParseARN(resource); err != nil {
+			add(summaryMalformedARN, "resource %q is not a valid ARN", resource)
+		}
+	}
+
+	for operator := range s.Condition {
+		if !isValidConditionOperator(operator) {
+			add(summaryInvalidConditionOperator, "condition operator %q is not valid", operator)
+		}
+	}
+
+	if s.Effect == "Allow" && len(s.Condition) == 0 && isWildcardPrincipal(s.Principal) {
+		add(summaryUnconditionalPublicAccess, `Principal "*" allows access to anyone; add a Condition to restrict access`)
+	}
+
+	return findings
+}
+
+// isKnownAction returns whether an action name (which may contain wildcards) matches an action in the catalog.
+// The catalog currently covers only a few services (see actions.hcl); actions for services not present in it
+// are always considered known.
+func isKnownAction(service, name string) bool {
+	actions, ok := catalog[service]
+	if !ok {
+		return true
+	}
+
+	// Action names are case-insensitive.
+	name = strings.ToLower(name)
+
+	return slices.ContainsFunc(actions, func(action string) bool {
+		return inttypes.WildcardMatch(name, strings.ToLower(action))
+	})
+}
+
+func isValidConditionOperator(operator string) bool {
+	operator = strings.ToLower(operator)
+
+	var isSetOperator bool
+	for _, prefix := range []string{"forallvalues:", "foranyvalue:"} {
+		if v, ok := strings.CutPrefix(operator, prefix); ok {
+			operator, isSetOperator = v, true
+			break
+		}
+	}
+
+	if v, ok := strings.CutSuffix(operator, "ifexists"); ok {
+		if v == "null" {
+			return false
+		}
+		operator = v
+	}
+
+	if isSetOperator && operator == "null" {
+		return false
+	}
+
+	return conditionOperators[operator]
+}
+
+// isWildcardPrincipal returns whether a Principal element grants access to all principals,
+// either as "*" or as {"AWS": "*"}.
+func isWildcardPrincipal(principal json.RawMessage) bool {
+	if len(principal) == 0 {
+		return false
+	}
+
+	var s string
+	if err := json.Unmarshal(principal, &s); err == nil {
+		return s == wildcard
+	}
+
+	var m map[string]policyStringSet
+	if err := json.Unmarshal(principal, &m); err != nil {
+		return false
+	}
+
+	return slices.Contains(m["AWS"], wildcard)
+}
+
+// policyStringSet is a policy element that may be expressed as a single string or a list of strings.
+type policyStringSet []string
+
+func (ss *policyStringSet) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*ss = policyStringSet{s}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+
+	*ss = list
+	return nil
+}
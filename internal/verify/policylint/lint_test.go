@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policylint
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document string
+		maxSize  int
+		expected []string
+	}{
+		"invalid JSON": {
+			document: `{`,
+		},
+		"valid": {
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": ["sqs:SendMessage", "sqs:Receive*", "s3:GetObject"],
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:example",
+    "Condition": {"ForAnyValue:StringLike": {"aws:PrincipalTag/team": "a*"}, "BoolIfExists": {"aws:SecureTransport": "true"}}
+  }]
+}`,
+		},
+		"single statement": {
+			document: `{"Statement": {"Effect": "Permit", "Action": "*", "Resource": "*"}}`,
+			expected: []string{summaryInvalidEffect},
+		},
+		"invalid action": {
+			document: `{"Statement": [{"Sid": "one", "Effect": "Allow", "Action": ["s3GetObject", "S3:GetObject", "s3:Get-Object"], "Resource": "*"}]}`,
+			expected: []string{summaryInvalidAction, summaryInvalidAction, summaryInvalidAction},
+		},
+		"unknown action": {
+			document: `{"Statement": [{"Effect": "Deny", "NotAction": ["sts:AssumeRolez", "sts:assumerole", "sns:Get*", "sns:Frob*"], "Resource": "*"}]}`,
+			expected: []string{summaryUnknownAction, summaryUnknownAction},
+		},
+		"malformed ARN": {
+			document: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": ["arn:aws:s3:::example/*", "example/*", "arn:aws:s3"]}]}`,
+			expected: []string{summaryMalformedARN, summaryMalformedARN},
+		},
+		"invalid condition operator": {
+			document: `{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringEqual": {"aws:username": "a"}, "NullIfExists": {"aws:username": "true"}, "stringequals": {"aws:username": "b"}}}]}`,
+			expected: []string{summaryInvalidConditionOperator, summaryInvalidConditionOperator},
+		},
+		"unconditional public access": {
+			document: `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": ["123456789012", "*"]}, "Action": "s3:GetObject", "Resource": "*"}]}`,
+			expected: []string{summaryUnconditionalPublicAccess},
+		},
+		"conditional public access": {
+			document: `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringEquals": {"aws:PrincipalOrgID": "o-example"}}}]}`,
+		},
+		"public deny": {
+			document: `{"Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:*", "Resource": "*"}]}`,
+		},
+		"too large": {
+			document: `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": "*"}]}`,
+			maxSize:  32,
+			expected: []string{summaryPolicyTooLarge},
+		},
+		"within size": {
+			document: `{ "Statement" : [ { "Effect" : "Allow", "Action" : "*", "Resource" : "*" } ] }`,
+			maxSize:  62,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, finding := range Lint(testCase.document, testCase.maxSize) {
+				got = append(got, finding.Summary)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("Lint() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -168,6 +168,16 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// DNSSuffixForPartition returns the domain suffix for the given partition.
+// Returns the standard partition's domain suffix if the partition has none.
+func DNSSuffixForPartition(partition endpoints.Partition) string {
+	if dnsSuffix := partition.DNSSuffix(); dnsSuffix != "" {
+		return dnsSuffix
+	}
+
+	return "amazonaws.com"
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
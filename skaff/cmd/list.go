@@ -8,11 +8,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	listOp           string
+	idField          string
+	displayNameField string
+)
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Create scaffolding for a list resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return list.Create(name, snakeName, !clearComments, framework, force)
+		return list.Create(name, snakeName, listOp, idField, displayNameField, !clearComments, framework, force)
 	},
 }
 
@@ -23,4 +29,7 @@ func init() {
 	listCmd.Flags().StringVarP(&name, "name", "n", "", "name of the entity")
 	listCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	listCmd.Flags().BoolVarP(&framework, "framework", "p", false, "use scaffolding for resources written using framework")
+	listCmd.Flags().StringVarP(&listOp, "list-op", "o", "", "AWS SDK operation whose item iterator is generated by listpages (e.g., DescribeDBInstances)")
+	listCmd.Flags().StringVar(&idField, "id-field", "", "field of each listed item holding the resource ID (e.g., DbiResourceId); if not set with --list-op, items are IDs")
+	listCmd.Flags().StringVar(&displayNameField, "display-name-field", "", "field of each listed item holding the display name (e.g., DBInstanceIdentifier); defaults to the resource ID")
 }
@@ -51,9 +51,14 @@ type TemplateData struct {
 	AWSServiceName         string
 	HumanListResourceName  string
 	ProviderResourceName   string
+	ListOp                 string
+	ListIterator           string
+	IDExpr                 string
+	DisplayNameExpr        string
+	ImportAWS              bool
 }
 
-func Create(listName, snakeName string, comments, framework, force bool) error {
+func Create(listName, snakeName, listOp, idField, displayNameField string, comments, framework, force bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -77,6 +82,10 @@ func Create(listName, snakeName string, comments, framework, force bool) error {
 		snakeName = names.ToSnakeCase(listName)
 	}
 
+	if listOp != "" && listOp == strings.ToLower(listOp) {
+		return fmt.Errorf("error checking: list operation should be the AWS SDK operation name (e.g., DescribeDBInstances)")
+	}
+
 	service, err := data.LookupService(servicePackage)
 	if err != nil {
 		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
@@ -96,8 +105,36 @@ func Create(listName, snakeName string, comments, framework, force bool) error {
 		AWSServiceName:         service.FullHumanFriendly(),
 		HumanListResourceName:  convert.ToHumanResName(listName),
 		ProviderResourceName:   convert.ToProviderResourceName(servicePackage, snakeName),
+		ListOp:                 listOp,
 	}
 
+	// Item iterators are generated by internal/generate/listpages using the -Items flag.
+	if listOp != "" {
+		templateData.ListIterator = convert.ToLowercasePrefix(listOp) + "Items"
+	}
+
+	switch {
+	case idField != "":
+		templateData.IDExpr = fmt.Sprintf("aws.ToString(item.%s)", idField)
+	case listOp != "":
+		templateData.IDExpr = "item"
+	default:
+		templateData.IDExpr = fmt.Sprintf("aws.ToString(item.%sArn)", listName)
+	}
+
+	switch {
+	case displayNameField != "":
+		templateData.DisplayNameExpr = fmt.Sprintf("aws.ToString(item.%s)", displayNameField)
+	case framework:
+		templateData.DisplayNameExpr = fmt.Sprintf("aws.ToString(item.%sId)", listName)
+	case listOp != "":
+		templateData.DisplayNameExpr = "id"
+	default:
+		templateData.DisplayNameExpr = fmt.Sprintf("aws.ToString(item.%sName)", listName)
+	}
+
+	templateData.ImportAWS = strings.Contains(templateData.IDExpr, "aws.") || strings.Contains(templateData.DisplayNameExpr, "aws.")
+
 	tmpl := listTmplFramework
 	if !framework {
 		tmpl = listTmplSdkV2